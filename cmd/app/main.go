@@ -13,6 +13,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/VmesteApp/auth-service/config"
 	_ "github.com/VmesteApp/auth-service/docs"
@@ -20,6 +21,20 @@ import (
 )
 
 func main() {
+	// migrate needs only database, so rest of service settings may be absent
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		pgCfg, err := config.NewPGConfig()
+		if err != nil {
+			log.Fatalf("can't init config: %s", err)
+		}
+
+		if err := app.Migrate(pgCfg, os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %s", err)
+		}
+
+		return
+	}
+
 	cfg, err := config.NewConfig()
 	if err != nil {
 		log.Fatalf("can't init config: %s", err)
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+)
+
+type cli struct {
+	admin  *usecase.AdminUseCase
+	output string
+}
+
+type command func(ctx context.Context, c *cli, args []string) error
+
+var commands = map[string]command{
+	"list-admins":    listAdmins,
+	"create-admin":   createAdmin,
+	"delete-admin":   deleteAdmin,
+	"restore-admin":  restoreAdmin,
+	"reset-password": resetPassword,
+	"set-role":       setRole,
+	"suspend-user":   suspendUser,
+	"ban-user":       banUser,
+	"activate-user":  activateUser,
+	"list-users":     listUsers,
+	"social-logins":  socialLogins,
+}
+
+func listAdmins(ctx context.Context, c *cli, args []string) error {
+	if err := parse("list-admins", args, nil); err != nil {
+		return err
+	}
+
+	admins, err := c.admin.Admins(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(admins))
+	for _, admin := range admins {
+		rows = append(rows, []string{strconv.FormatUint(admin.UserID, 10), admin.Email})
+	}
+
+	return c.print(admins, []string{"ID", "EMAIL"}, rows)
+}
+
+func createAdmin(ctx context.Context, c *cli, args []string) error {
+	var email, password string
+
+	if err := parse("create-admin", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&email, "email", "", "admin email")
+		fs.StringVar(&password, "password", "", "admin password, read from stdin if empty")
+	}); err != nil {
+		return err
+	}
+	if email == "" {
+		return errors.New("-email is required")
+	}
+
+	password, err := readPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return c.admin.CreateAdmin(ctx, 0, email, password)
+}
+
+func deleteAdmin(ctx context.Context, c *cli, args []string) error {
+	userID, err := parseUserID("delete-admin", args, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.admin.DeleteAdmin(ctx, 0, userID)
+}
+
+func restoreAdmin(ctx context.Context, c *cli, args []string) error {
+	userID, err := parseUserID("restore-admin", args, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.admin.RestoreAdmin(ctx, 0, userID)
+}
+
+func resetPassword(ctx context.Context, c *cli, args []string) error {
+	var password string
+
+	userID, err := parseUserID("reset-password", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&password, "password", "", "new password, read from stdin if empty")
+	})
+	if err != nil {
+		return err
+	}
+
+	password, err = readPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return c.admin.ResetPassword(ctx, 0, userID, password)
+}
+
+func setRole(ctx context.Context, c *cli, args []string) error {
+	var role string
+
+	userID, err := parseUserID("set-role", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&role, "role", "", "user, admin or superadmin")
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.admin.ChangeRole(ctx, 0, userID, entity.Role(role))
+}
+
+func suspendUser(ctx context.Context, c *cli, args []string) error {
+	var (
+		period time.Duration
+		reason string
+	)
+
+	userID, err := parseUserID("suspend-user", args, func(fs *flag.FlagSet) {
+		fs.DurationVar(&period, "for", 24*time.Hour, "suspension period")
+		fs.StringVar(&reason, "reason", "", "suspension reason")
+	})
+	if err != nil {
+		return err
+	}
+
+	until := time.Now().Add(period)
+
+	return c.admin.ChangeStatus(ctx, 0, userID, entity.SuspendedStatus, &until, reason)
+}
+
+func banUser(ctx context.Context, c *cli, args []string) error {
+	var reason string
+
+	userID, err := parseUserID("ban-user", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&reason, "reason", "", "ban reason")
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.admin.ChangeStatus(ctx, 0, userID, entity.BannedStatus, nil, reason)
+}
+
+func activateUser(ctx context.Context, c *cli, args []string) error {
+	userID, err := parseUserID("activate-user", args, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.admin.ChangeStatus(ctx, 0, userID, entity.ActiveStatus, nil, "")
+}
+
+func listUsers(ctx context.Context, c *cli, args []string) error {
+	var (
+		filter entity.UserFilter
+		role   string
+		sort   string
+		cursor string
+	)
+
+	if err := parse("list-users", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&role, "role", "", "filter by role")
+		fs.StringVar(&filter.Provider, "provider", "", "filter by social login provider")
+		fs.StringVar(&filter.EmailPrefix, "email", "", "filter by email prefix")
+		fs.IntVar(&filter.VkID, "vk-id", 0, "filter by VK ID")
+		fs.StringVar(&sort, "sort", "id", "id or created_at")
+		fs.BoolVar(&filter.Desc, "desc", false, "descending order")
+		fs.StringVar(&cursor, "cursor", "", "next cursor of the previous page")
+		fs.Uint64Var(&filter.Limit, "limit", 50, "max users")
+	}); err != nil {
+		return err
+	}
+
+	filter.Role = entity.Role(role)
+	filter.Sort = entity.UserSort(sort)
+
+	page, err := c.admin.Users(ctx, filter, cursor)
+	if err != nil {
+		return err
+	}
+	users := page.Users
+
+	rows := make([][]string, 0, len(users))
+	for _, user := range users {
+		providers := make([]string, 0, len(user.SocialLogins))
+		for _, socialLogin := range user.SocialLogins {
+			providers = append(providers, socialLogin.Provider)
+		}
+
+		rows = append(rows, []string{
+			strconv.FormatUint(user.ID, 10),
+			user.Email,
+			string(user.Role),
+			string(user.Status),
+			strings.Join(providers, ","),
+		})
+	}
+
+	if err := c.print(page, []string{"ID", "EMAIL", "ROLE", "STATUS", "PROVIDERS"}, rows); err != nil {
+		return err
+	}
+
+	// JSON output already contains cursor
+	if page.NextCursor != "" && c.output != "json" {
+		fmt.Fprintf(os.Stderr, "next cursor: %s\n", page.NextCursor)
+	}
+
+	return nil
+}
+
+func socialLogins(ctx context.Context, c *cli, args []string) error {
+	userID, err := parseUserID("social-logins", args, nil)
+	if err != nil {
+		return err
+	}
+
+	socialLogins, err := c.admin.SocialLogins(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(socialLogins))
+	for _, socialLogin := range socialLogins {
+		rows = append(rows, []string{strconv.FormatUint(socialLogin.ID, 10), socialLogin.Provider, socialLogin.ProviderID})
+	}
+
+	return c.print(socialLogins, []string{"ID", "PROVIDER", "PROVIDER ID"}, rows)
+}
+
+func parse(name string, args []string, define func(fs *flag.FlagSet)) error {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	if define != nil {
+		define(fs)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %v", fs.Args())
+	}
+
+	return nil
+}
+
+func parseUserID(name string, args []string, define func(fs *flag.FlagSet)) (uint64, error) {
+	var userID uint64
+
+	err := parse(name, args, func(fs *flag.FlagSet) {
+		fs.Uint64Var(&userID, "id", 0, "user id")
+
+		if define != nil {
+			define(fs)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	if userID == 0 {
+		return 0, errors.New("-id is required")
+	}
+
+	return userID, nil
+}
+
+func readPassword(password string) (string, error) {
+	if password != "" {
+		return password, nil
+	}
+
+	fmt.Fprint(os.Stderr, "password: ")
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("can't read password: %w", err)
+	}
+
+	password = strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", errors.New("password is empty")
+	}
+
+	return password, nil
+}
@@ -0,0 +1,93 @@
+// Command authctl manages users and admins directly in the database.
+//
+// Usage:
+//
+//	authctl [-o table|json] <command> [flags]
+//
+// Commands:
+//
+//	list-admins
+//	create-admin   -email E [-password P]
+//	delete-admin   -id ID
+//	restore-admin  -id ID
+//	reset-password -id ID [-password P]
+//	set-role       -id ID -role user|admin|superadmin
+//	suspend-user   -id ID [-for 24h] [-reason R]
+//	ban-user       -id ID [-reason R]
+//	activate-user  -id ID
+//	list-users     [-role R] [-provider P] [-email PREFIX] [-vk-id ID] [-sort id|created_at] [-desc] [-cursor C] [-limit N]
+//	social-logins  -id ID
+//
+// Password is read from stdin when -password is omitted. Database is configured the same way as the service:
+// config/config.yml and PG_URL.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+	"github.com/VmesteApp/auth-service/internal/usecase/repo"
+	"github.com/VmesteApp/auth-service/pkg/postgres"
+)
+
+func main() {
+	output := flag.String("o", "table", "output format: table or json")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	if *output != "table" && *output != "json" {
+		fatalf("unknown output format %q", *output)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.NewPGConfig()
+	if err != nil {
+		fatalf("can't init config: %s", err)
+	}
+
+	auditCfg, err := config.NewAuditConfig()
+	if err != nil {
+		fatalf("can't init config: %s", err)
+	}
+
+	pg, err := postgres.New(cfg.URL, postgres.MaxPoolSize(1))
+	if err != nil {
+		fatalf("can't connect database: %s", err)
+	}
+	defer pg.Close()
+
+	c := &cli{
+		admin:  usecase.NewAdminUseCase(repo.NewUserRepository(pg, []byte(auditCfg.Secret))),
+		output: *output,
+	}
+
+	if err := cmd(context.Background(), c, flag.Args()[1:]); err != nil {
+		pg.Close()
+		fatalf("%s: %s", flag.Arg(0), err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: authctl [-o table|json] <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands: list-admins, create-admin, delete-admin, restore-admin, reset-password, set-role,")
+	fmt.Fprintln(os.Stderr, "          suspend-user, ban-user, activate-user, list-users, social-logins")
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "authctl: "+format+"\n", args...)
+	os.Exit(1)
+}
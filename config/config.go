@@ -1,7 +1,10 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"regexp"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -9,21 +12,46 @@ import (
 
 type (
 	Config struct {
+		App              `yaml:"app"`
 		HTTP             `yaml:"http"`
 		GRPC             `yaml:"grpc"`
 		Log              `yaml:"logger"`
 		PG               `yaml:"postgres"`
 		VkAPI            `yaml:"vk_api"`
+		Telegram         `yaml:"telegram"`
+		OAuth            `yaml:"oauth"`
 		JwtConfig        `yaml:"jwt"`
+		Audit            `yaml:"audit"`
 		SuperAdminConfig `yaml:"superadmin"`
 	}
 
+	App struct {
+		// ShutdownTimeout - time to drain in-flight HTTP requests and gRPC calls on shutdown.
+		ShutdownTimeout time.Duration `env-default:"15s" yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+		// PreStopDelay - time between readiness failure and closing listeners, so balancers stop routing to instance.
+		PreStopDelay time.Duration `env-default:"0s" yaml:"pre_stop_delay" env:"PRE_STOP_DELAY"`
+		// ProbeTimeout - time limit of every readiness check.
+		ProbeTimeout time.Duration `env-default:"2s" yaml:"probe_timeout" env:"PROBE_TIMEOUT"`
+	}
+
 	HTTP struct {
 		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		// TrustedProxies - networks whose X-Forwarded-For is used as client IP, none by default.
+		TrustedProxies []string `yaml:"trusted_proxies" env:"HTTP_TRUSTED_PROXIES" env-separator:","`
 	}
 
 	GRPC struct {
-		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		Port string `env-required:"true" yaml:"port" env:"GRPC_PORT"`
+
+		// TLS is enabled when cert and key are set, client certificates are verified only with client CA.
+		TLSCertFile  string `yaml:"tls_cert_file" env:"GRPC_TLS_CERT_FILE"`
+		TLSKeyFile   string `yaml:"tls_key_file" env:"GRPC_TLS_KEY_FILE"`
+		ClientCAFile string `yaml:"client_ca_file" env:"GRPC_CLIENT_CA_FILE"`
+		// ClientRoles - roles of mTLS clients by certificate common name.
+		ClientRoles map[string]string `yaml:"client_roles"`
+
+		Reflection     bool          `env-default:"false" yaml:"reflection" env:"GRPC_REFLECTION"`
+		HealthInterval time.Duration `env-default:"5s" yaml:"health_interval" env:"GRPC_HEALTH_INTERVAL"`
 	}
 
 	Log struct {
@@ -33,12 +61,64 @@ type (
 	PG struct {
 		PoolMax int    `env-required:"true" yaml:"pool_max" env:"PG_POOL_MAX"`
 		URL     string `env-required:"true" yaml:"pg_url" env:"PG_URL"`
+		// AutoMigrate - apply embedded migrations on start.
+		AutoMigrate bool `env-default:"false" yaml:"auto_migrate" env:"PG_AUTO_MIGRATE"`
 	}
 
 	VkAPI struct {
 		AppId      int    `env-required:"true" yaml:"app_id" env:"VK_APP_ID"`
 		PrivateKey string `env-required:"true" env:"VK_PRIVATE_KEY"`
 		ServiceKey string `env-required:"true" env:"VK_SERVICE_KEY"`
+
+		BaseURL string        `env-default:"https://api.vk.com" yaml:"base_url" env:"VK_API_BASE_URL"`
+		Version string        `env-default:"5.101" yaml:"version" env:"VK_API_VERSION"`
+		Timeout time.Duration `env-default:"5s" yaml:"timeout" env:"VK_API_TIMEOUT"`
+
+		RetryAttempts    int           `env-default:"3" yaml:"retry_attempts" env:"VK_API_RETRY_ATTEMPTS"`
+		RetryBackoff     time.Duration `env-default:"100ms" yaml:"retry_backoff" env:"VK_API_RETRY_BACKOFF"`
+		BreakerThreshold int           `env-default:"5" yaml:"breaker_threshold" env:"VK_API_BREAKER_THRESHOLD"`
+		BreakerTimeout   time.Duration `env-default:"30s" yaml:"breaker_timeout" env:"VK_API_BREAKER_TIMEOUT"`
+
+		// TokenCacheSize - max validated access tokens in cache, 0 disables cache.
+		TokenCacheSize int           `env-default:"0" yaml:"token_cache_size" env:"VK_API_TOKEN_CACHE_SIZE"`
+		TokenCacheTTL  time.Duration `env-default:"5m" yaml:"token_cache_ttl" env:"VK_API_TOKEN_CACHE_TTL"`
+
+		ProfileRefreshInterval time.Duration `env-default:"24h" yaml:"profile_refresh_interval" env:"VK_PROFILE_REFRESH_INTERVAL"`
+
+		LaunchParamsTTL time.Duration `env-default:"24h" yaml:"launch_params_ttl" env:"VK_LAUNCH_PARAMS_TTL"`
+		// ReplayCacheSize - max remembered launch params signatures, 0 disables replay protection.
+		// Signatures are remembered for LaunchParamsTTL, so it must be non-zero. Alive signatures are never
+		// pushed out: when the cache is full, VK logins fail with 503 until the oldest ones expire,
+		// so size must exceed the peak of VK logins per LaunchParamsTTL.
+		ReplayCacheSize int `env-default:"0" yaml:"replay_cache_size" env:"VK_REPLAY_CACHE_SIZE"`
+
+		// ReadinessCheck - VK API reachability is part of readiness.
+		ReadinessCheck bool `env-default:"false" yaml:"readiness_check" env:"VK_API_READINESS_CHECK"`
+	}
+
+	Telegram struct {
+		// BotToken - empty token disables Telegram login.
+		BotToken string        `env:"TELEGRAM_BOT_TOKEN"`
+		AuthTTL  time.Duration `env-required:"true" yaml:"auth_ttl" env:"TELEGRAM_AUTH_TTL"`
+	}
+
+	OAuth struct {
+		StateTTL  time.Duration   `env-default:"10m" yaml:"state_ttl" env:"OAUTH_STATE_TTL"`
+		Providers []OAuthProvider `yaml:"providers"`
+	}
+
+	// OAuthProvider - upstream provider, client_secret may be a whole env reference like ${YANDEX_CLIENT_SECRET}.
+	OAuthProvider struct {
+		Name         string   `yaml:"name"`
+		Type         string   `yaml:"type"`
+		ClientID     string   `yaml:"client_id"`
+		ClientSecret string   `yaml:"client_secret"`
+		RedirectURL  string   `yaml:"redirect_url"`
+		Scopes       []string `yaml:"scopes"`
+		Issuer       string   `yaml:"issuer"`
+		AuthURL      string   `yaml:"auth_url"`
+		TokenURL     string   `yaml:"token_url"`
+		UserInfoURL  string   `yaml:"userinfo_url"`
 	}
 
 	JwtConfig struct {
@@ -46,9 +126,28 @@ type (
 		TTL    time.Duration `env-required:"true" yaml:"token_ttl" env:"JWT_TOKEN_TTL"`
 	}
 
+	Audit struct {
+		// Secret - HMAC key of audit log hash chain, events hashed with another key fail verification.
+		Secret string `env-required:"true" env:"AUDIT_SECRET"`
+		// AnonymousFailureInterval - failed logins of unknown users are audited once per interval per IP.
+		AnonymousFailureInterval time.Duration `env-default:"1m" yaml:"anonymous_failure_interval" env:"AUDIT_ANONYMOUS_FAILURE_INTERVAL"`
+		// AnonymousFailureCacheSize - max remembered IPs of such failures, 0 disables the limit.
+		AnonymousFailureCacheSize int `env-default:"10000" yaml:"anonymous_failure_cache_size" env:"AUDIT_ANONYMOUS_FAILURE_CACHE_SIZE"`
+	}
+
 	SuperAdminConfig struct {
-		Email    string `env-required:"true" env:"SUPER_ADMIN_EMAIL"`
-		Password string `env-required:"true" env:"SUPER_ADMIN_PASSWORD"`
+		SuperAdmin `yaml:",inline"`
+		// Accounts - additional superadmins, password fields may be a whole env reference like ${ADMIN_PASSWORD}.
+		Accounts []SuperAdmin `yaml:"accounts"`
+		// OnlyIfNone - create configured superadmins only when database has no superadmin yet.
+		OnlyIfNone bool `env-default:"false" yaml:"only_if_none" env:"SUPER_ADMIN_ONLY_IF_NONE"`
+	}
+
+	// SuperAdmin - either plaintext password or pre-computed bcrypt hash is required.
+	SuperAdmin struct {
+		Email        string `yaml:"email" env:"SUPER_ADMIN_EMAIL"`
+		Password     string `yaml:"password" env:"SUPER_ADMIN_PASSWORD"`
+		PasswordHash string `yaml:"password_hash" env:"SUPER_ADMIN_PASSWORD_HASH"`
 	}
 )
 
@@ -65,5 +164,85 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("can't read env: %w", err)
 	}
 
+	// Without max age signature must be remembered forever, which bounded cache can't do
+	if cfg.VkAPI.ReplayCacheSize > 0 && cfg.VkAPI.LaunchParamsTTL == 0 {
+		return nil, errors.New("vk replay cache requires non-zero launch params ttl")
+	}
+
+	for i := range cfg.OAuth.Providers {
+		p := &cfg.OAuth.Providers[i]
+		if p.ClientSecret, err = lookupEnvRef(p.ClientSecret); err != nil {
+			return nil, fmt.Errorf("oauth provider %s client_secret: %w", p.Name, err)
+		}
+	}
+
+	for i := range cfg.SuperAdminConfig.Accounts {
+		a := &cfg.SuperAdminConfig.Accounts[i]
+		if a.Password, err = lookupEnvRef(a.Password); err != nil {
+			return nil, fmt.Errorf("superadmin %s password: %w", a.Email, err)
+		}
+		if a.PasswordHash, err = lookupEnvRef(a.PasswordHash); err != nil {
+			return nil, fmt.Errorf("superadmin %s password_hash: %w", a.Email, err)
+		}
+	}
+
 	return cfg, nil
 }
+
+// NewPGConfig - only postgres config, for tools working with database without the rest of service settings.
+func NewPGConfig() (*PG, error) {
+	cfg := &struct {
+		PG `yaml:"postgres"`
+	}{}
+
+	if err := readConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg.PG, nil
+}
+
+// NewAuditConfig - only audit config, for tools writing audit events.
+func NewAuditConfig() (*Audit, error) {
+	cfg := &struct {
+		Audit `yaml:"audit"`
+	}{}
+
+	if err := readConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg.Audit, nil
+}
+
+func readConfig(cfg any) error {
+	err := cleanenv.ReadConfig("./config/config.yml", cfg)
+	if err != nil {
+		return fmt.Errorf("can't read yml config: %w", err)
+	}
+
+	err = cleanenv.ReadEnv(cfg)
+	if err != nil {
+		return fmt.Errorf("can't read env: %w", err)
+	}
+
+	return nil
+}
+
+// _envRef - value consisting only of ${NAME}, anything else is taken literally,
+// so bcrypt hashes and passwords with $ stay as they are.
+var _envRef = regexp.MustCompile(`^\$\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+func lookupEnvRef(value string) (string, error) {
+	m := _envRef.FindStringSubmatch(value)
+	if m == nil {
+		return value, nil
+	}
+
+	v, ok := os.LookupEnv(m[1])
+	if !ok {
+		return "", fmt.Errorf("env variable %s is not set", m[1])
+	}
+
+	return v, nil
+}
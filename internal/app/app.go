@@ -1,22 +1,32 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 
 	"github.com/VmesteApp/auth-service/config"
 	profileGRPC "github.com/VmesteApp/auth-service/internal/controller/grpc/profile"
+	userGRPC "github.com/VmesteApp/auth-service/internal/controller/grpc/user"
 	v1 "github.com/VmesteApp/auth-service/internal/controller/http/v1"
 	"github.com/VmesteApp/auth-service/internal/usecase"
 	"github.com/VmesteApp/auth-service/internal/usecase/repo"
 	"github.com/VmesteApp/auth-service/internal/usecase/webapi"
+	"github.com/VmesteApp/auth-service/pkg/breaker"
+	"github.com/VmesteApp/auth-service/pkg/gateway"
 	"github.com/VmesteApp/auth-service/pkg/httpserver"
 	"github.com/VmesteApp/auth-service/pkg/logger"
 	"github.com/VmesteApp/auth-service/pkg/postgres"
@@ -27,64 +37,185 @@ func Run(cfg *config.Config) {
 	l.Info("logger init")
 
 	// DB
+	if cfg.PG.AutoMigrate {
+		if err := AutoMigrate(cfg); err != nil {
+			l.Fatal(fmt.Errorf("app - Run - AutoMigrate: %w", err))
+		}
+	}
+
 	pg, err := postgres.New(cfg.PG.URL, postgres.MaxPoolSize(cfg.PG.PoolMax))
 
 	if err != nil {
 		l.Fatal(fmt.Errorf("app - Run - postgres.New: %w", err))
 	}
+	// Closed the last, after servers are drained
 	defer pg.Close()
 	l.Info("connected to database")
 
 	// Permissions
-	err = InitSuperAdmin(pg, cfg.SuperAdminConfig)
+	superAdmins, err := InitSuperAdmin(pg, cfg.SuperAdminConfig)
 	if err != nil {
 		l.Fatal(fmt.Errorf("app - Run - app.InitSuperAdmin: %w", err))
 	}
-	l.Info("%s is current superadmin!", cfg.SuperAdminConfig.Email)
+	for _, email := range superAdmins {
+		l.Info("%s is superadmin!", email)
+	}
 
 	// Usecases
-	userRepository := repo.NewUserRepository(pg)
+	userRepository := repo.NewUserRepository(pg, []byte(cfg.Audit.Secret))
+
+	oauthProviders, err := newOAuthProviders(cfg.OAuth)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - newOAuthProviders: %w", err))
+	}
 
-	userUseCase := usecase.New(userRepository, webapi.New(cfg.AppId, cfg.ServiceKey), cfg.JwtConfig.Secret, cfg.JwtConfig.TTL, cfg.PrivateKey)
+	vkWebApi := webapi.New(
+		cfg.AppId,
+		cfg.ServiceKey,
+		webapi.BaseURL(cfg.VkAPI.BaseURL),
+		webapi.Version(cfg.VkAPI.Version),
+		webapi.Timeout(cfg.VkAPI.Timeout),
+		webapi.Retry(cfg.VkAPI.RetryAttempts, cfg.VkAPI.RetryBackoff),
+		webapi.CircuitBreaker(breaker.New(
+			breaker.Threshold(cfg.VkAPI.BreakerThreshold),
+			breaker.OpenTimeout(cfg.VkAPI.BreakerTimeout),
+			breaker.OnStateChange(webapi.ObserveBreakerState),
+		)),
+		webapi.TokenCache(cfg.VkAPI.TokenCacheSize, cfg.VkAPI.TokenCacheTTL),
+	)
+
+	userUseCase := usecase.New(
+		userRepository,
+		vkWebApi,
+		cfg.JwtConfig.Secret,
+		cfg.JwtConfig.TTL,
+		cfg.PrivateKey,
+		usecase.VkLaunchParams(cfg.VkAPI.AppId, cfg.VkAPI.LaunchParamsTTL, cfg.VkAPI.ReplayCacheSize),
+		usecase.VkProfileRefresh(cfg.VkAPI.ProfileRefreshInterval),
+		usecase.TelegramBot(cfg.Telegram.BotToken, cfg.Telegram.AuthTTL),
+		usecase.OAuthProviders(oauthProviders, cfg.OAuth.StateTTL),
+		usecase.AnonymousAudit(cfg.Audit.AnonymousFailureInterval, cfg.Audit.AnonymousFailureCacheSize),
+		usecase.Logger(l),
+	)
 	adminUseCase := usecase.NewAdminUseCase(userRepository)
+	roleUseCase := usecase.NewRoleUseCase(userRepository)
+	auditUseCase := usecase.NewAuditUseCase(userRepository, []byte(cfg.Audit.Secret))
 	profileUseCase := usecase.NewProfileUseCase(userRepository)
 
+	// gRPC
+	unaryInterceptors, streamInterceptors := newGRPCInterceptors(cfg, l, userUseCase.TokenValid)
+	healthServer := health.NewServer()
+
+	gRPCServer, err := newGRPCServer(cfg, unaryInterceptors, streamInterceptors, healthServer)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - newGRPCServer: %w", err))
+	}
+
+	rpcGateway := gateway.New(gateway.Interceptors(unaryInterceptors...))
+
+	for _, registrar := range []grpc.ServiceRegistrar{gRPCServer, rpcGateway} {
+		profileGRPC.Register(registrar, userRepository)
+		userGRPC.Register(registrar, userRepository)
+	}
+
 	// HTTP
+	readiness, err := newReadiness(cfg, pg, vkWebApi)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - newReadiness: %w", err))
+	}
+
 	handler := gin.New()
-	v1.NewRouter(handler, l, userUseCase, adminUseCase, profileUseCase, cfg)
+	if err := handler.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - SetTrustedProxies: %w", err))
+	}
 
-	httpServer := httpserver.New(handler, httpserver.Port(cfg.HTTP.Port))
+	v1.NewRouter(handler, l, userUseCase, adminUseCase, roleUseCase, auditUseCase, profileUseCase, rpcGateway, readiness, cfg)
 
-	// gRPC
-	gRPCServer := grpc.NewServer()
-	profileGRPC.Register(gRPCServer, userRepository)
+	httpServer := httpserver.New(
+		handler,
+		httpserver.Port(cfg.HTTP.Port),
+		httpserver.ShutdownTimeout(cfg.App.ShutdownTimeout),
+	)
 
 	gRPClistener, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GRPC.Port))
 	if err != nil {
 		l.Fatal("failed list gRPC: %s", err)
 	}
 
-	l.Info("gRPC is runnig: %s", slog.String("addr", gRPClistener.Addr().String()))
+	// Waiting signal or the first server failure
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	if err := gRPCServer.Serve(gRPClistener); err != nil {
-		l.Fatal("failed serve gRPC listener: %s", err)
-	}
+	group, ctx := errgroup.WithContext(ctx)
 
-	// Waiting signal
-	interrupt := make(chan os.Signal, 1)
-	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	group.Go(func() error {
+		err := <-httpServer.Notify()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("httpServer.Notify: %w", err)
+		}
 
-	select {
-	case s := <-interrupt:
-		l.Info("app - Run - signal: " + s.String())
-	case err = <-httpServer.Notify():
-		l.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", err))
-	}
+		return nil
+	})
+
+	group.Go(func() error {
+		l.Info("gRPC is runnig: %s", slog.String("addr", gRPClistener.Addr().String()))
+
+		if err := gRPCServer.Serve(gRPClistener); err != nil {
+			return fmt.Errorf("gRPCServer.Serve: %w", err)
+		}
+
+		return nil
+	})
+
+	group.Go(func() error {
+		watchDBHealth(ctx, pg, healthServer, cfg.GRPC.HealthInterval)
+
+		return nil
+	})
 
 	// Shutdown
-	err = httpServer.Shutdown()
-	if err != nil {
-		l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
+	group.Go(func() error {
+		<-ctx.Done()
+
+		l.Info("app - Run - shutting down")
+
+		readiness.SetReady(false)
+		healthServer.Shutdown()
+
+		// Balancers need some probes to notice readiness failure, meanwhile requests are still served
+		time.Sleep(cfg.App.PreStopDelay)
+
+		// Both servers are drained together, so shutdown takes ShutdownTimeout at most
+		var drain sync.WaitGroup
+
+		drain.Add(2)
+
+		go func() {
+			defer drain.Done()
+
+			if err := httpServer.Shutdown(); err != nil {
+				l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
+			}
+		}()
+
+		go func() {
+			defer drain.Done()
+
+			if !stopGRPC(gRPCServer, cfg.App.ShutdownTimeout) {
+				l.Warn("app - Run - gRPC calls are not drained in %s, connections closed", cfg.App.ShutdownTimeout)
+			}
+		}()
+
+		drain.Wait()
+
+		return nil
+	})
+
+	readiness.SetReady(true)
+
+	if err := group.Wait(); err != nil {
+		l.Error(fmt.Errorf("app - Run - %w", err))
 	}
-	gRPCServer.GracefulStop()
+
+	l.Info("app - Run - stopped")
 }
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/interceptors"
+	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/postgres"
+)
+
+const _dbPingTimeout = time.Second
+
+// newGRPCInterceptors - the same unary chain is used by gRPC server and HTTP gateway.
+func newGRPCInterceptors(
+	cfg *config.Config,
+	l logger.Interface,
+	tokenCheck interceptors.TokenCheck,
+) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	public := []string{healthpb.Health_Check_FullMethodName, healthpb.Health_Watch_FullMethodName}
+	if cfg.GRPC.Reflection {
+		public = append(public,
+			reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName,
+			reflectionpbalpha.ServerReflection_ServerReflectionInfo_FullMethodName,
+		)
+	}
+
+	auth := interceptors.NewAuth(
+		cfg.JwtConfig.Secret,
+		interceptors.Public(public...),
+		interceptors.ClientRoles(cfg.GRPC.ClientRoles),
+		interceptors.CheckTokens(tokenCheck),
+		interceptors.Allow("/profile.ProfileService/", string(entity.ServiceRole), string(entity.AdminRole), string(entity.SuperAdminRole)),
+		interceptors.Allow("/auth.v1.ProfileService/", string(entity.ServiceRole), string(entity.AdminRole), string(entity.SuperAdminRole)),
+		interceptors.Allow("/auth.v1.UserService/", string(entity.ServiceRole), string(entity.AdminRole), string(entity.SuperAdminRole)),
+	)
+
+	unary := []grpc.UnaryServerInterceptor{
+		interceptors.UnaryLogger(l),
+		interceptors.UnaryMetrics(),
+		interceptors.UnaryRecovery(l),
+		auth.Unary(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		interceptors.StreamLogger(l),
+		interceptors.StreamMetrics(),
+		interceptors.StreamRecovery(l),
+		auth.Stream(),
+	}
+
+	return unary, stream
+}
+
+// newGRPCServer - server with health and optional reflection, services are registered by caller.
+func newGRPCServer(
+	cfg *config.Config,
+	unary []grpc.UnaryServerInterceptor,
+	stream []grpc.StreamServerInterceptor,
+	healthServer *health.Server,
+) (*grpc.Server, error) {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+
+	if cfg.GRPC.TLSCertFile != "" || cfg.GRPC.TLSKeyFile != "" {
+		tlsConfig, err := newGRPCTLSConfig(cfg.GRPC)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(opts...)
+
+	healthpb.RegisterHealthServer(server, healthServer)
+	if cfg.GRPC.Reflection {
+		reflection.Register(server)
+	}
+
+	return server, nil
+}
+
+// stopGRPC - wait for in-flight calls within timeout, then close connections, reports whether calls were drained.
+func stopGRPC(server *grpc.Server, timeout time.Duration) bool {
+	stopped := make(chan struct{})
+
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		server.Stop()
+
+		return false
+	}
+}
+
+// watchDBHealth - serving status of the whole server follows database availability until ctx is done.
+func watchDBHealth(ctx context.Context, pg *postgres.Postgres, healthServer *health.Server, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pingCtx, cancel := context.WithTimeout(ctx, _dbPingTimeout)
+		err := pg.Pool.Ping(pingCtx)
+		cancel()
+
+		if err != nil {
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		} else {
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newGRPCTLSConfig - client certificate is optional, callers without it authenticate by bearer token.
+func newGRPCTLSConfig(cfg config.GRPC) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't load gRPC key pair: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't read gRPC client CA: %w", err)
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no certificates in gRPC client CA")
+		}
+
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsConfig, nil
+}
@@ -1,17 +1,20 @@
-//go:build migrate
-
 package app
 
 import (
 	"errors"
+	"fmt"
 	"log"
-	"os"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	// migrate tools
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/migrations"
 )
 
 const (
@@ -19,25 +22,92 @@ const (
 	_defaultTimeout  = time.Second
 )
 
-func init() {
-	databaseURL, ok := os.LookupEnv("PG_URL")
-	if !ok || len(databaseURL) == 0 {
-		log.Fatalf("migrate: environment variable not declared: PG_URL")
+// Migrate - run migrate subcommand: up, down [N], status or force V.
+func Migrate(cfg *config.PG, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [N]|status|force V")
+	}
+
+	m, err := newMigrate(cfg.URL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	switch args[0] {
+	case "up":
+		return migrateUp(m)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid steps %q", args[1])
+			}
+		}
+
+		err = m.Steps(-steps)
+		if err != nil {
+			return fmt.Errorf("can't migrate down: %w", err)
+		}
+
+		log.Printf("Migrate: down %d success", steps)
+	case "status":
+		return migrateStatus(m)
+	case "force":
+		if len(args) < 2 {
+			return errors.New("usage: migrate force V")
+		}
+
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+
+		err = m.Force(version)
+		if err != nil {
+			return fmt.Errorf("can't force version: %w", err)
+		}
+
+		log.Printf("Migrate: forced version %d", version)
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+
+	return nil
+}
+
+// AutoMigrate - apply embedded migrations on start. Postgres driver holds advisory lock while migrating,
+// so replicas started together wait for each other instead of racing.
+func AutoMigrate(cfg *config.Config) error {
+	m, err := newMigrate(cfg.PG.URL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	return migrateUp(m)
+}
+
+func newMigrate(pgURL string) (*migrate.Migrate, error) {
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("can't open embedded migrations: %w", err)
 	}
 
-	databaseURL += "?sslmode=disable"
+	databaseURL, err := migrateDatabaseURL(pgURL)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		attempts = _defaultAttempts
-		err      error
 		m        *migrate.Migrate
 	)
 
 	for attempts > 0 {
-		m, err = migrate.New("file://migrations", databaseURL)
-
+		m, err = migrate.NewWithSourceInstance("iofs", source, databaseURL)
 		if err == nil {
-			break
+			return m, nil
 		}
 
 		log.Printf("Migrate: postgres is trying to connect, attempts left: %d, %s", attempts, err)
@@ -45,20 +115,58 @@ func init() {
 		attempts--
 	}
 
+	return nil, fmt.Errorf("can't connect postgres: %w", err)
+}
+
+// migrateDatabaseURL - lib/pq requires TLS by default, unlike pgx, so sslmode is disabled unless set in PG_URL.
+func migrateDatabaseURL(pgURL string) (string, error) {
+	u, err := url.Parse(pgURL)
 	if err != nil {
-		log.Fatalf("Migrate: postgres connect error: %s", err)
+		return "", fmt.Errorf("can't parse PG_URL: %w", err)
 	}
 
-	err = m.Up()
-	defer m.Close()
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		log.Fatalf("Migrate: up error: %s", err)
+	q := u.Query()
+	if !q.Has("sslmode") {
+		q.Set("sslmode", "disable")
+		u.RawQuery = q.Encode()
 	}
 
+	return u.String(), nil
+}
+
+func migrateUp(m *migrate.Migrate) error {
+	err := m.Up()
 	if errors.Is(err, migrate.ErrNoChange) {
 		log.Printf("Migrate: no change")
-		return
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("can't migrate up: %w", err)
 	}
 
 	log.Printf("Migrate: up success")
+
+	return nil
+}
+
+func migrateStatus(m *migrate.Migrate) error {
+	expected, err := migrations.Version()
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		log.Printf("Migrate: no migrations applied, embedded version %d", expected)
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("can't get version: %w", err)
+	}
+
+	log.Printf("Migrate: version %d, dirty %t, embedded version %d", version, dirty, expected)
+
+	return nil
 }
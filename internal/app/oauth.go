@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+	"github.com/VmesteApp/auth-service/internal/usecase/webapi"
+)
+
+const _oauthDiscoveryTimeout = 10 * time.Second
+
+func newOAuthProviders(cfg config.OAuth) (map[string]usecase.OAuthProvider, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), _oauthDiscoveryTimeout)
+	defer cancel()
+
+	providers := make(map[string]usecase.OAuthProvider, len(cfg.Providers))
+
+	for _, providerCfg := range cfg.Providers {
+		if _, ok := providers[providerCfg.Name]; ok || providerCfg.Name == "" {
+			return nil, fmt.Errorf("provider name %q is empty or duplicated", providerCfg.Name)
+		}
+
+		provider, err := webapi.NewOAuthProvider(ctx, providerCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		providers[providerCfg.Name] = provider
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/internal/usecase/webapi"
+	"github.com/VmesteApp/auth-service/migrations"
+	"github.com/VmesteApp/auth-service/pkg/postgres"
+	"github.com/VmesteApp/auth-service/pkg/probe"
+)
+
+func newReadiness(cfg *config.Config, pg *postgres.Postgres, vk *webapi.VkWebApi) (*probe.Probe, error) {
+	expectedVersion, err := migrations.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []probe.Option{
+		probe.Timeout(cfg.App.ProbeTimeout),
+		probe.Check("postgres", func(ctx context.Context) error {
+			return pg.Pool.Ping(ctx)
+		}),
+		probe.Check("migrations", func(ctx context.Context) error {
+			return checkMigrationVersion(ctx, pg, expectedVersion)
+		}),
+	}
+
+	if cfg.VkAPI.ReadinessCheck {
+		opts = append(opts, probe.Check("vk_api", vk.Ping))
+	}
+
+	return probe.New(opts...), nil
+}
+
+// checkMigrationVersion - schema_migrations is maintained by golang-migrate.
+// Newer schema is fine: during rolling deploy old instances keep serving after new ones migrated.
+func checkMigrationVersion(ctx context.Context, pg *postgres.Postgres, expected uint) error {
+	var (
+		version uint
+		dirty   bool
+	)
+
+	err := pg.Pool.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("no migrations applied, expected version %d", expected)
+	}
+	if err != nil {
+		return fmt.Errorf("can't get migration version: %w", err)
+	}
+
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < expected {
+		return fmt.Errorf("migration version %d, expected at least %d", version, expected)
+	}
+
+	return nil
+}
@@ -2,28 +2,114 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/VmesteApp/auth-service/config"
 	"github.com/VmesteApp/auth-service/internal/entity"
 	"github.com/VmesteApp/auth-service/pkg/postgres"
-	"golang.org/x/crypto/bcrypt"
 )
 
-func InitSuperAdmin(pg *postgres.Postgres, cfg config.SuperAdminConfig) error {
-	_, err := pg.Pool.Exec(context.Background(), "DELETE FROM users WHERE role = $1", entity.SuperAdminRole)
+// InitSuperAdmin - upsert configured superadmins by email, keeping their user ids between deploys.
+// Superadmins missing in config are left untouched. Returns emails of created or updated superadmins.
+func InitSuperAdmin(pg *postgres.Postgres, cfg config.SuperAdminConfig) ([]string, error) {
+	ctx := context.Background()
+
+	accounts := cfg.Accounts
+	if cfg.Email != "" {
+		accounts = append([]config.SuperAdmin{cfg.SuperAdmin}, accounts...)
+	}
+
+	var exists bool
+
+	err := pg.Pool.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE role = $1)", entity.SuperAdminRole).Scan(&exists)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("can't check superadmins: %w", err)
+	}
+
+	if len(accounts) == 0 {
+		if !exists {
+			return nil, errors.New("no superadmin in database and config")
+		}
+
+		return nil, nil
+	}
+
+	if cfg.OnlyIfNone && exists {
+		return nil, nil
+	}
+
+	emails := make([]string, 0, len(accounts))
+
+	for _, account := range accounts {
+		if err := upsertSuperAdmin(ctx, pg, account); err != nil {
+			return nil, fmt.Errorf("can't init superadmin %s: %w", account.Email, err)
+		}
+
+		emails = append(emails, account.Email)
+	}
+
+	return emails, nil
+}
+
+func upsertSuperAdmin(ctx context.Context, pg *postgres.Postgres, account config.SuperAdmin) error {
+	if account.Email == "" {
+		return errors.New("email is empty")
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cfg.Password), bcrypt.DefaultCost)
+	passHash, err := superAdminPassHash(ctx, pg, account)
 	if err != nil {
 		return err
 	}
 
-	_, err = pg.Pool.Exec(context.Background(), "INSERT INTO users (email, pass_hash, role) VALUES ($1, $2, $3)", cfg.Email, hashedPassword, entity.SuperAdminRole)
+	sql := `
+	INSERT INTO users (email, pass_hash, role) VALUES ($1, $2, $3)
+		ON CONFLICT (email) DO UPDATE SET pass_hash = EXCLUDED.pass_hash, role = EXCLUDED.role, deleted_at = NULL
+		WHERE users.pass_hash IS DISTINCT FROM EXCLUDED.pass_hash OR users.role IS DISTINCT FROM EXCLUDED.role
+			OR users.deleted_at IS NOT NULL
+	`
+
+	_, err = pg.Pool.Exec(ctx, sql, account.Email, passHash, entity.SuperAdminRole)
 	if err != nil {
-		return err
+		return fmt.Errorf("can't save superadmin: %w", err)
 	}
 
 	return nil
 }
+
+// superAdminPassHash - configured hash as is, plaintext password is hashed only if it differs from the stored one,
+// so restarts don't rewrite the row.
+func superAdminPassHash(ctx context.Context, pg *postgres.Postgres, account config.SuperAdmin) ([]byte, error) {
+	if account.PasswordHash != "" {
+		if _, err := bcrypt.Cost([]byte(account.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("password hash is not bcrypt: %w", err)
+		}
+
+		return []byte(account.PasswordHash), nil
+	}
+
+	if account.Password == "" {
+		return nil, errors.New("password or password hash is required")
+	}
+
+	var current []byte
+
+	err := pg.Pool.QueryRow(ctx, "SELECT pass_hash FROM users WHERE email = $1", account.Email).Scan(&current)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("can't get current password hash: %w", err)
+	}
+
+	if current != nil && bcrypt.CompareHashAndPassword(current, []byte(account.Password)) == nil {
+		return current, nil
+	}
+
+	passHash, err := bcrypt.GenerateFromPassword([]byte(account.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("can't generate password hash: %w", err)
+	}
+
+	return passHash, nil
+}
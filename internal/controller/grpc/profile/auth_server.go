@@ -0,0 +1,163 @@
+package profile
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	authv1 "github.com/VmesteApp/auth-service/pkg/api/auth/v1"
+)
+
+const _maxBatchSize = 1000
+
+// authServerApi implements auth.v1.ProfileService, which supersedes the legacy profile.ProfileService.
+type authServerApi struct {
+	authv1.UnimplementedProfileServiceServer
+	profile Profile
+}
+
+func (s *authServerApi) GetVkID(ctx context.Context, req *authv1.GetVkIDRequest) (*authv1.GetVkIDResponse, error) {
+	vkProfile, err := s.vkProfile(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.GetVkIDResponse{
+		VkID: int64(vkProfile.VkID),
+	}, nil
+}
+
+func (s *authServerApi) GetVkProfile(ctx context.Context, req *authv1.GetVkProfileRequest) (*authv1.GetVkProfileResponse, error) {
+	vkProfile, err := s.vkProfile(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.GetVkProfileResponse{
+		UserID:        int64(vkProfile.UserID),
+		VkID:          int64(vkProfile.VkID),
+		LaunchContext: toLaunchContext(vkProfile.LaunchContext),
+		Info:          toUserInfo(vkProfile.Info),
+	}, nil
+}
+
+func (s *authServerApi) GetVkIDs(ctx context.Context, req *authv1.GetVkIDsRequest) (*authv1.GetVkIDsResponse, error) {
+	if len(req.GetUserIDs()) > _maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many ids, max %d", _maxBatchSize)
+	}
+
+	userIDs := make([]uint64, 0, len(req.GetUserIDs()))
+	for _, userID := range req.GetUserIDs() {
+		if userID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid user id")
+		}
+
+		userIDs = append(userIDs, uint64(userID))
+	}
+
+	vkIDs, err := s.profile.VkIDs(ctx, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get vk ids")
+	}
+
+	res := &authv1.GetVkIDsResponse{VkIDs: make(map[int64]int64, len(vkIDs))}
+	for _, userID := range userIDs {
+		vkID, ok := vkIDs[userID]
+		if !ok {
+			res.NotFound = append(res.NotFound, int64(userID))
+
+			continue
+		}
+
+		res.VkIDs[int64(userID)] = int64(vkID)
+	}
+
+	return res, nil
+}
+
+func (s *authServerApi) GetUserIDsByVkIDs(ctx context.Context, req *authv1.GetUserIDsByVkIDsRequest) (*authv1.GetUserIDsByVkIDsResponse, error) {
+	if len(req.GetVkIDs()) > _maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many ids, max %d", _maxBatchSize)
+	}
+
+	vkIDs := make([]int, 0, len(req.GetVkIDs()))
+	for _, vkID := range req.GetVkIDs() {
+		if vkID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid vk id")
+		}
+
+		vkIDs = append(vkIDs, int(vkID))
+	}
+
+	userIDs, err := s.profile.UserIDsByVkIDs(ctx, vkIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get user ids")
+	}
+
+	res := &authv1.GetUserIDsByVkIDsResponse{UserIDs: make(map[int64]int64, len(userIDs))}
+	for _, vkID := range vkIDs {
+		userID, ok := userIDs[vkID]
+		if !ok {
+			res.NotFound = append(res.NotFound, int64(vkID))
+
+			continue
+		}
+
+		res.UserIDs[int64(vkID)] = int64(userID)
+	}
+
+	return res, nil
+}
+
+func (s *authServerApi) vkProfile(ctx context.Context, userID int64) (entity.VkProfile, error) {
+	if userID <= 0 {
+		return entity.VkProfile{}, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	vkProfile, err := s.profile.VkProfile(ctx, uint64(userID))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return entity.VkProfile{}, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return entity.VkProfile{}, status.Error(codes.Internal, "failed get vk profile")
+	}
+
+	return vkProfile, nil
+}
+
+func toLaunchContext(c *entity.VkLaunchContext) *authv1.VkLaunchContext {
+	if c == nil {
+		return nil
+	}
+
+	return &authv1.VkLaunchContext{
+		Platform:                c.Platform,
+		Language:                c.Language,
+		IsAppUser:               c.IsAppUser,
+		AreNotificationsEnabled: c.AreNotificationsEnabled,
+		IsFavorite:              c.IsFavorite,
+		Ref:                     c.Ref,
+		GroupID:                 c.GroupID,
+		ViewerGroupRole:         c.ViewerGroupRole,
+		ChatID:                  c.ChatID,
+		LaunchedAt:              timestamppb.New(c.LaunchedAt),
+	}
+}
+
+func toUserInfo(info *entity.VkUserInfo) *authv1.VkUserInfo {
+	if info == nil {
+		return nil
+	}
+
+	return &authv1.VkUserInfo{
+		FirstName:  info.FirstName,
+		LastName:   info.LastName,
+		PhotoURL:   info.PhotoURL,
+		ScreenName: info.ScreenName,
+		UpdatedAt:  timestamppb.New(info.UpdatedAt),
+	}
+}
@@ -3,9 +3,9 @@ package profile
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
+	authv1 "github.com/VmesteApp/auth-service/pkg/api/auth/v1"
 	profilev1 "github.com/VmesteApp/protobuf/gen/go/profile"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +14,8 @@ import (
 
 type Profile interface {
 	VkProfile(context context.Context, userID uint64) (entity.VkProfile, error)
+	VkIDs(ctx context.Context, userIDs []uint64) (map[uint64]int, error)
+	UserIDsByVkIDs(ctx context.Context, vkIDs []int) (map[int]uint64, error)
 }
 
 type serverApi struct {
@@ -21,20 +23,18 @@ type serverApi struct {
 	profile Profile
 }
 
-func Register(gRPC *grpc.Server, profile Profile) {
+func Register(gRPC grpc.ServiceRegistrar, profile Profile) {
 	profilev1.RegisterProfileServiceServer(gRPC, &serverApi{profile: profile})
+	authv1.RegisterProfileServiceServer(gRPC, &authServerApi{profile: profile})
 }
 
 func (s *serverApi) GetVkID(context context.Context, req *profilev1.GetVkIDRequest) (*profilev1.GetVkIDResponse, error) {
-	fmt.Println(uint64(req.UserID))
-	fmt.Println(req)
 	vkProfile, err := s.profile.VkProfile(context, uint64(req.UserID))
 
 	if errors.Is(err, entity.ErrUserNotFound) {
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 	if err != nil {
-		fmt.Println(err)
 		return nil, status.Error(codes.Internal, "failed get vk id")
 	}
 
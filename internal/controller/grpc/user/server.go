@@ -0,0 +1,197 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	authv1 "github.com/VmesteApp/auth-service/pkg/api/auth/v1"
+)
+
+const (
+	_defaultPageSize = 50
+	_maxPageSize     = 500
+)
+
+type Users interface {
+	User(ctx context.Context, email string) (*entity.User, error)
+	UserByID(ctx context.Context, userID uint64) (*entity.User, error)
+	Users(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+	SocialLogins(ctx context.Context, userID uint64) ([]*entity.SocialLogin, error)
+}
+
+type serverApi struct {
+	authv1.UnimplementedUserServiceServer
+	users Users
+}
+
+func Register(gRPC grpc.ServiceRegistrar, users Users) {
+	authv1.RegisterUserServiceServer(gRPC, &serverApi{users: users})
+}
+
+func (s *serverApi) GetUser(ctx context.Context, req *authv1.GetUserRequest) (*authv1.GetUserResponse, error) {
+	if req.GetUserID() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	user, err := s.users.UserByID(ctx, uint64(req.GetUserID()))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get user")
+	}
+
+	return &authv1.GetUserResponse{User: toUser(user)}, nil
+}
+
+func (s *serverApi) GetUserByEmail(ctx context.Context, req *authv1.GetUserByEmailRequest) (*authv1.GetUserByEmailResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+
+	user, err := s.users.User(ctx, req.GetEmail())
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get user")
+	}
+
+	return &authv1.GetUserByEmailResponse{User: toUser(user)}, nil
+}
+
+func (s *serverApi) ListUsers(ctx context.Context, req *authv1.ListUsersRequest) (*authv1.ListUsersResponse, error) {
+	role := entity.Role(req.GetRole())
+	if role != "" && role != entity.UserRole && role != entity.AdminRole && role != entity.SuperAdminRole {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	pageSize := uint64(_defaultPageSize)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+	if req.GetPageSize() > 0 {
+		pageSize = min(uint64(req.GetPageSize()), _maxPageSize)
+	}
+
+	afterID, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	filter := entity.UserFilter{Role: role, Limit: pageSize + 1}
+	if afterID > 0 {
+		filter.After = &entity.UserCursor{ID: afterID}
+	}
+
+	users, err := s.users.Users(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed list users")
+	}
+
+	res := &authv1.ListUsersResponse{}
+	if uint64(len(users)) > pageSize {
+		users = users[:pageSize]
+		res.NextPageToken = encodePageToken(users[len(users)-1].ID)
+	}
+
+	for _, user := range users {
+		res.Users = append(res.Users, toUser(user))
+	}
+
+	return res, nil
+}
+
+func (s *serverApi) GetSocialLogins(ctx context.Context, req *authv1.GetSocialLoginsRequest) (*authv1.GetSocialLoginsResponse, error) {
+	if req.GetUserID() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	_, err := s.users.UserByID(ctx, uint64(req.GetUserID()))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get user")
+	}
+
+	socialLogins, err := s.users.SocialLogins(ctx, uint64(req.GetUserID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get social logins")
+	}
+
+	return &authv1.GetSocialLoginsResponse{SocialLogins: toSocialLogins(socialLogins)}, nil
+}
+
+func (s *serverApi) GetUserStatus(ctx context.Context, req *authv1.GetUserStatusRequest) (*authv1.GetUserStatusResponse, error) {
+	if req.GetUserID() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	user, err := s.users.UserByID(ctx, uint64(req.GetUserID()))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed get user")
+	}
+
+	res := &authv1.GetUserStatusResponse{
+		Status:  string(user.Status),
+		Blocked: user.Blocked(time.Now()),
+		Reason:  user.StatusReason,
+	}
+	if user.StatusUntil != nil {
+		res.Until = timestamppb.New(*user.StatusUntil)
+	}
+
+	return res, nil
+}
+
+func toUser(user *entity.User) *authv1.User {
+	return &authv1.User{
+		Id:           int64(user.ID),
+		Email:        user.Email,
+		Role:         string(user.Role),
+		SocialLogins: toSocialLogins(user.SocialLogins),
+	}
+}
+
+func toSocialLogins(socialLogins []*entity.SocialLogin) []*authv1.SocialLogin {
+	res := make([]*authv1.SocialLogin, 0, len(socialLogins))
+	for _, socialLogin := range socialLogins {
+		res = append(res, &authv1.SocialLogin{
+			Id:         int64(socialLogin.ID),
+			Provider:   socialLogin.Provider,
+			ProviderID: socialLogin.ProviderID,
+		})
+	}
+
+	return res
+}
+
+func encodePageToken(lastID uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(lastID, 10)))
+}
+
+func decodePageToken(token string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(raw), 10, 64)
+}
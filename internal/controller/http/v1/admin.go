@@ -4,10 +4,12 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
 	"github.com/VmesteApp/auth-service/internal/usecase"
 	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/middlewares"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,9 +24,16 @@ func newAdminRoutes(handler *gin.RouterGroup, u usecase.Admin, l logger.Interfac
 		u: u,
 	}
 
-	handler.GET("/", routes.doGetAllAdmins)
-	handler.POST("/", routes.doCreateNewAdmin)
-	handler.DELETE("/:id", routes.doDeleteAdmin)
+	canRead := middlewares.RequirePermission(string(entity.AdminsReadPermission))
+	canWrite := middlewares.RequirePermission(string(entity.AdminsWritePermission))
+
+	handler.GET("/", canRead, routes.doGetAllAdmins)
+	handler.POST("/", canWrite, routes.doCreateNewAdmin)
+	handler.DELETE("/:id", canWrite, routes.doDeleteAdmin)
+	handler.POST("/:id/restore", canWrite, routes.doRestoreAdmin)
+	handler.GET("/users", middlewares.RequirePermission(string(entity.UsersReadPermission)), routes.doGetUsers)
+	handler.PUT("/users/:id/status", middlewares.RequirePermission(string(entity.UsersModeratePermission)), routes.doChangeStatus)
+	handler.PUT("/users/:id/role", middlewares.RoleMiddleware(string(entity.SuperAdminRole)), routes.doChangeRole)
 }
 
 // @Summary     Get all admins
@@ -79,7 +88,7 @@ func (a *adminRoutes) doCreateNewAdmin(ctx *gin.Context) {
 		return
 	}
 
-	err := a.u.CreateAdmin(ctx.Request.Context(), request.Email, request.Password)
+	err := a.u.CreateAdmin(ctx.Request.Context(), ctx.GetUint64("uid"), request.Email, request.Password)
 	if errors.Is(err, entity.ErrUserExists) {
 		errorResponse(ctx, http.StatusConflict, "email already used")
 
@@ -96,25 +105,38 @@ func (a *adminRoutes) doCreateNewAdmin(ctx *gin.Context) {
 }
 
 // @Summary     Delete admin
-// @Description Delete admin by id (method for superadmin)
+// @Description Soft delete admin by id (method for superadmin), admin can be restored later
 // @ID          admin-delete
 // @Tags  	    admins
 // @Accept      json
 // @Produce     json
 // @Param       id   path      int  true  "User ID"
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     404
+// @Failure     500
 // @Router      /admin/{id} [delete]
 // @Security    BearerAuth
 func (a *adminRoutes) doDeleteAdmin(ctx *gin.Context) {
-	id := ctx.Param("id")
-
-	userID, err := strconv.Atoi(id)
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
 	if err != nil {
 		errorResponse(ctx, http.StatusBadRequest, "invalid ID")
 
 		return
 	}
 
-	err = a.u.DeleteAdmin(ctx.Request.Context(), uint64(userID))
+	err = a.u.DeleteAdmin(ctx.Request.Context(), ctx.GetUint64("uid"), userID)
+	if errors.Is(err, entity.ErrSelfAction) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
+	if errors.Is(err, entity.ErrUserNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "admin not found")
+
+		return
+	}
 	if err != nil {
 		a.l.Error(err, "http - v1 - doDeleteAdmin")
 		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
@@ -124,3 +146,245 @@ func (a *adminRoutes) doDeleteAdmin(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, nil)
 }
+
+// @Summary     Restore admin
+// @Description Restore deleted admin by id (method for superadmin)
+// @ID          admin-restore
+// @Tags  	    admins
+// @Accept      json
+// @Produce     json
+// @Param       id   path      int  true  "User ID"
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Router      /admin/{id}/restore [post]
+// @Security    BearerAuth
+func (a *adminRoutes) doRestoreAdmin(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid ID")
+
+		return
+	}
+
+	err = a.u.RestoreAdmin(ctx.Request.Context(), ctx.GetUint64("uid"), userID)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "deleted admin not found")
+
+		return
+	}
+	if err != nil {
+		a.l.Error(err, "http - v1 - doRestoreAdmin")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type doChangeRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// @Summary     Change user role
+// @Description Change role of user, e.g. promote VK user to admin (method for superadmin). Issued tokens of user are revoked
+// @ID          user-change-role
+// @Tags  	    admins
+// @Param       id   path      int  true  "User ID"
+// @Param 			request body doChangeRoleRequest true "query params"
+// @Accept      json
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     404
+// @Failure     409
+// @Failure     500
+// @Produce     json
+// @Router      /admin/users/{id}/role [put]
+// @Security    BearerAuth
+func (a *adminRoutes) doChangeRole(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid ID")
+
+		return
+	}
+
+	var request doChangeRoleRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	err = a.u.ChangeRole(ctx.Request.Context(), ctx.GetUint64("uid"), userID, entity.Role(request.Role))
+	if errors.Is(err, entity.ErrBadRole) {
+		errorResponse(ctx, http.StatusBadRequest, "unknown role")
+
+		return
+	}
+	if errors.Is(err, entity.ErrUserNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "user not found")
+
+		return
+	}
+	if errors.Is(err, entity.ErrLastSuperAdmin) {
+		errorResponse(ctx, http.StatusConflict, "last superadmin can't be demoted")
+
+		return
+	}
+	if err != nil {
+		a.l.Error(err, "http - v1 - doChangeRole")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type doGetUsersRequest struct {
+	Role        string    `form:"role"`
+	Provider    string    `form:"provider"`
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Email       string    `form:"email"`
+	VkID        int       `form:"vk_id"`
+	Sort        string    `form:"sort" binding:"omitempty,oneof=id created_at"`
+	Order       string    `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor      string    `form:"cursor"`
+	Limit       uint64    `form:"limit" binding:"omitempty,max=100"`
+}
+
+// @Summary     Search users
+// @Description Page of users with social logins, filtered and sorted. Pass nextCursor to get the next page
+// @ID          user-list
+// @Tags  	    admins
+// @Param       role         query string false "Role"
+// @Param       provider     query string false "Social login provider, e.g. vk"
+// @Param       created_from query string false "Created at or after, RFC3339"
+// @Param       created_to   query string false "Created before, RFC3339"
+// @Param       email        query string false "Email prefix"
+// @Param       vk_id        query int    false "VK ID"
+// @Param       sort         query string false "id (default) or created_at"
+// @Param       order        query string false "asc (default) or desc"
+// @Param       cursor       query string false "nextCursor of the previous page"
+// @Param       limit        query int    false "Default 50, max 100"
+// @Accept      json
+// @Success     200 {object} entity.UserPage
+// @Failure     400
+// @Failure     403
+// @Failure     500
+// @Produce     json
+// @Router      /admin/users [get]
+// @Security    BearerAuth
+func (a *adminRoutes) doGetUsers(ctx *gin.Context) {
+	var request doGetUsersRequest
+
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	filter := entity.UserFilter{
+		Role:        entity.Role(request.Role),
+		Provider:    request.Provider,
+		CreatedFrom: request.CreatedFrom,
+		CreatedTo:   request.CreatedTo,
+		EmailPrefix: request.Email,
+		VkID:        request.VkID,
+		Sort:        entity.UserSort(request.Sort),
+		Desc:        request.Order == "desc",
+		Limit:       request.Limit,
+	}
+
+	page, err := a.u.Users(ctx.Request.Context(), filter, request.Cursor)
+	if errors.Is(err, entity.ErrBadCursor) || errors.Is(err, entity.ErrBadSort) {
+		errorResponse(ctx, http.StatusBadRequest, err.Error())
+
+		return
+	}
+	if err != nil {
+		a.l.Error(err, "http - v1 - doGetUsers")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, page)
+}
+
+type doChangeStatusRequest struct {
+	Status string     `json:"status" binding:"required,oneof=active suspended banned"`
+	Until  *time.Time `json:"until"`
+	Reason string     `json:"reason"`
+}
+
+// @Summary     Change user status
+// @Description Suspend user till time, ban with reason or reactivate. Blocked users can't login and their tokens are rejected. Only users with lower role than caller can be moderated
+// @ID          user-change-status
+// @Tags  	    admins
+// @Param       id   path      int  true  "User ID"
+// @Param 			request body doChangeStatusRequest true "query params"
+// @Accept      json
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /admin/users/{id}/status [put]
+// @Security    BearerAuth
+func (a *adminRoutes) doChangeStatus(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid ID")
+
+		return
+	}
+
+	var request doChangeStatusRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	err = a.u.ChangeStatus(
+		ctx.Request.Context(),
+		ctx.GetUint64("uid"),
+		userID,
+		entity.Status(request.Status),
+		request.Until,
+		request.Reason,
+	)
+	if errors.Is(err, entity.ErrBadStatus) {
+		errorResponse(ctx, http.StatusBadRequest, "suspension requires future until")
+
+		return
+	}
+	if errors.Is(err, entity.ErrSelfAction) || errors.Is(err, entity.ErrProtectedUser) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
+	if errors.Is(err, entity.ErrUserNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "user not found")
+
+		return
+	}
+	if err != nil {
+		a.l.Error(err, "http - v1 - doChangeStatus")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
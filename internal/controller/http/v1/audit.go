@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/middlewares"
+)
+
+type auditRoutes struct {
+	u usecase.Audit
+	l logger.Interface
+}
+
+func newAuditRoutes(handler *gin.RouterGroup, u usecase.Audit, l logger.Interface) {
+	routes := &auditRoutes{
+		l: l,
+		u: u,
+	}
+
+	canRead := middlewares.RequirePermission(string(entity.AuditReadPermission))
+
+	handler.GET("/audit", canRead, routes.doGetEvents)
+	handler.GET("/audit/verify", canRead, routes.doVerify)
+}
+
+type doGetEventsRequest struct {
+	ActorID  uint64    `form:"actor_id"`
+	TargetID uint64    `form:"target_id"`
+	Action   string    `form:"action"`
+	Outcome  string    `form:"outcome" binding:"omitempty,oneof=success failure"`
+	From     time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Cursor   string    `form:"cursor"`
+	Limit    uint64    `form:"limit" binding:"omitempty,max=500"`
+}
+
+// @Summary     Search audit events
+// @Description Page of audit events, newest first. Pass nextCursor to get the next page (method for superadmin)
+// @ID          audit-list
+// @Tags  	    audit
+// @Param       actor_id  query int    false "Actor user ID"
+// @Param       target_id query int    false "Target user ID"
+// @Param       action    query string false "Action, e.g. user.login"
+// @Param       outcome   query string false "success or failure"
+// @Param       from      query string false "Created at or after, RFC3339"
+// @Param       to        query string false "Created before, RFC3339"
+// @Param       cursor    query string false "nextCursor of the previous page"
+// @Param       limit     query int    false "Default 50, max 500"
+// @Accept      json
+// @Success     200 {object} entity.AuditPage
+// @Failure     400
+// @Failure     403
+// @Failure     500
+// @Produce     json
+// @Router      /admin/audit [get]
+// @Security    BearerAuth
+func (a *auditRoutes) doGetEvents(ctx *gin.Context) {
+	var request doGetEventsRequest
+
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	filter := entity.AuditFilter{
+		ActorID:  request.ActorID,
+		TargetID: request.TargetID,
+		Action:   entity.AuditAction(request.Action),
+		Outcome:  entity.AuditOutcome(request.Outcome),
+		From:     request.From,
+		To:       request.To,
+		Limit:    request.Limit,
+	}
+
+	page, err := a.u.Events(ctx.Request.Context(), filter, request.Cursor)
+	if errors.Is(err, entity.ErrBadCursor) {
+		errorResponse(ctx, http.StatusBadRequest, err.Error())
+
+		return
+	}
+	if err != nil {
+		a.l.Error(err, "http - v1 - doGetEvents")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, page)
+}
+
+// @Summary     Verify audit log
+// @Description Check hash chain of the whole audit log, brokenId is the first tampered event (method for superadmin)
+// @ID          audit-verify
+// @Tags  	    audit
+// @Accept      json
+// @Success     200 {object} entity.AuditVerification
+// @Failure     403
+// @Failure     500
+// @Produce     json
+// @Router      /admin/audit/verify [get]
+// @Security    BearerAuth
+func (a *auditRoutes) doVerify(ctx *gin.Context) {
+	result, err := a.u.Verify(ctx.Request.Context())
+	if err != nil {
+		a.l.Error(err, "http - v1 - doVerify")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
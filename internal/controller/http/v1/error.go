@@ -1,7 +1,14 @@
 package v1
 
 import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
 	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
 )
 
 type response struct {
@@ -11,3 +18,12 @@ type response struct {
 func errorResponse(c *gin.Context, code int, msg string) {
 	c.AbortWithStatusJSON(code, response{msg})
 }
+
+func vkUnavailableResponse(c *gin.Context, err error) {
+	var unavailable *entity.VkUnavailableError
+	if errors.As(err, &unavailable) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(unavailable.RetryAfter.Seconds()))))
+	}
+
+	errorResponse(c, http.StatusServiceUnavailable, "vk is unavailable, try later")
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+const _oauthStateCookie = "oauth_state"
+
+// @Summary     Authorize by OAuth provider
+// @Description Redirect to upstream OAuth2/OIDC provider consent page
+// @ID          oauth-authorize
+// @Tags  	    login
+// @Param       provider   path      string  true  "Provider name from config"
+// @Success     302
+// @Failure     404
+// @Failure     500
+// @Router      /login/oauth/{provider}/authorize [get]
+func (r *userRoutes) doOAuthAuthorize(ctx *gin.Context) {
+	r.doAuthorize(ctx, ctx.Param("provider"))
+}
+
+// @Summary     OAuth provider callback
+// @Description Exchange authorization code and login by upstream identity
+// @ID          oauth-callback
+// @Tags  	    login
+// @Param       provider   path      string  true  "Provider name from config"
+// @Param       code       query     string  true  "Authorization code"
+// @Param       state      query     string  true  "State"
+// @Success     200  {object}  doLoginResponse
+// @Failure     400
+// @Failure     401
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /login/oauth/{provider}/callback [get]
+func (r *userRoutes) doOAuthCallback(ctx *gin.Context) {
+	r.doCallback(ctx, ctx.Param("provider"))
+}
+
+// @Summary     Authorize by VK ID
+// @Description Redirect to VK ID authorization page (authorization code flow with PKCE)
+// @ID          login-vk-authorize
+// @Tags  	    login
+// @Success     302
+// @Failure     404
+// @Failure     500
+// @Router      /login/vk/authorize [get]
+func (r *userRoutes) doVkAuthorize(ctx *gin.Context) {
+	r.doAuthorize(ctx, entity.VkProvider)
+}
+
+// @Summary     VK ID callback
+// @Description Exchange VK ID authorization code and login by VK user
+// @ID          login-vk-callback
+// @Tags  	    login
+// @Param       code       query     string  true  "Authorization code"
+// @Param       state      query     string  true  "State"
+// @Param       device_id  query     string  true  "VK ID device id"
+// @Success     200  {object}  doLoginResponse
+// @Failure     400
+// @Failure     401
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /login/vk/callback [get]
+func (r *userRoutes) doVkCallback(ctx *gin.Context) {
+	r.doCallback(ctx, entity.VkProvider)
+}
+
+func (r *userRoutes) doAuthorize(ctx *gin.Context, provider string) {
+	authURL, stateToken, err := r.u.OAuthAuthorize(provider)
+	if errors.Is(err, entity.ErrOAuthProviderNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "provider not found")
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doAuthorize")
+		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
+
+		return
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(_oauthStateCookie, stateToken, 0, "/auth/login", "", isSecure(ctx), true)
+	ctx.Redirect(http.StatusFound, authURL)
+}
+
+func (r *userRoutes) doCallback(ctx *gin.Context, provider string) {
+	stateToken, err := ctx.Cookie(_oauthStateCookie)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "wrong state")
+
+		return
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(_oauthStateCookie, "", -1, "/auth/login", "", isSecure(ctx), true)
+
+	user, token, err := r.u.OAuthLogin(ctx.Request.Context(), provider, stateToken, ctx.Request.URL.Query())
+	if errors.Is(err, entity.ErrOAuthProviderNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "provider not found")
+		return
+	}
+	if errors.Is(err, entity.ErrBadOAuthState) {
+		errorResponse(ctx, http.StatusBadRequest, "wrong state")
+		return
+	}
+	if errors.Is(err, entity.ErrBadOAuthCode) {
+		errorResponse(ctx, http.StatusUnauthorized, "wrong authorization code")
+		return
+	}
+	if errors.Is(err, entity.ErrUserSuspended) || errors.Is(err, entity.ErrUserBanned) || errors.Is(err, entity.ErrUserDeleted) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doCallback")
+		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
+
+		return
+	}
+
+	res := doLoginResponse{
+		Token:  token,
+		UserID: user.ID,
+		Role:   user.Role,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func isSecure(ctx *gin.Context) bool {
+	return ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https"
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/pkg/probe"
+)
+
+type probeRoutes struct {
+	p *probe.Probe
+}
+
+func newProbeRoutes(handler *gin.RouterGroup, p *probe.Probe) {
+	r := &probeRoutes{p}
+
+	handler.GET("/livez", r.doLive)
+	handler.GET("/readyz", r.doReady)
+	handler.GET("/healthz", r.doHealth)
+}
+
+// doLive - process is alive, dependencies are not checked.
+func (r *probeRoutes) doLive(ctx *gin.Context) {
+	ctx.Status(http.StatusOK)
+}
+
+// doReady - readiness with breakdown per dependency check.
+func (r *probeRoutes) doReady(ctx *gin.Context) {
+	report := r.p.Check(ctx.Request.Context())
+	if report.Status != probe.StatusUp {
+		ctx.JSON(http.StatusServiceUnavailable, report)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// doHealth - kept for existing probes, ready flag only.
+func (r *probeRoutes) doHealth(ctx *gin.Context) {
+	if !r.p.Ready() {
+		ctx.Status(http.StatusServiceUnavailable)
+
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/VmesteApp/auth-service/internal/entity"
 	"github.com/VmesteApp/auth-service/internal/usecase"
 	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/middlewares"
 )
 
 type profileRoutes struct {
@@ -21,15 +22,16 @@ func newProfileRoutes(handler *gin.RouterGroup, u usecase.Profile, l logger.Inte
 	r := &profileRoutes{u, l}
 
 	handler.GET("/:id/vk", r.doVkProfile)
+	handler.GET("/:id/vk/launches", middlewares.RequirePermission(string(entity.ProfilesReadPermission)), r.doVkLaunchContexts)
 }
 
 // @Summary     Get VK profile
-// @Description Get VK profile by user id
+// @Description Get VK profile by user id with public VK user info and the latest VK Mini App launch context
 // @ID          vk-profile
 // @Tags  	    profiles
 // @Param       id   path      int  true  "User ID"
 // @Accept      json
-// @Success     200
+// @Success     200  {object}  entity.VkProfile
 // @Failure     400
 // @Failure     401
 // @Failure     409
@@ -62,3 +64,62 @@ func (r *profileRoutes) doVkProfile(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, vkProfile)
 }
+
+const (
+	_defaultLaunchContextsLimit = 20
+	_maxLaunchContextsLimit     = 100
+)
+
+// @Summary     Get VK launch history
+// @Description Get VK Mini App launch contexts by user id, newest first. History of other users requires users:read permission
+// @ID          vk-launch-contexts
+// @Tags  	    profiles
+// @Param       id     path      int  true   "User ID"
+// @Param       limit  query     int  false  "Max items (default 20, max 100)"
+// @Accept      json
+// @Success     200  {array}  entity.VkLaunchContext
+// @Failure     400
+// @Failure     401
+// @Failure     403
+// @Failure     409
+// @Failure     500
+// @Produce     json
+// @Router      /profile/{id}/vk/launches [get]
+// @Security    BearerAuth
+func (r *profileRoutes) doVkLaunchContexts(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid ID")
+
+		return
+	}
+
+	// launch history tracks user activity, so it's private unless caller may read users
+	if userID != ctx.GetUint64("uid") && !middlewares.HasPermission(ctx, string(entity.UsersReadPermission)) {
+		errorResponse(ctx, http.StatusForbidden, "access denied")
+
+		return
+	}
+
+	limit, err := strconv.ParseUint(ctx.DefaultQuery("limit", strconv.Itoa(_defaultLaunchContextsLimit)), 10, 64)
+	if err != nil || limit == 0 {
+		errorResponse(ctx, http.StatusBadRequest, "invalid limit")
+
+		return
+	}
+
+	launchContexts, err := r.u.VkLaunchContexts(ctx.Request.Context(), userID, min(limit, _maxLaunchContextsLimit))
+	if errors.Is(err, entity.ErrUserNotFound) {
+		errorResponse(ctx, http.StatusConflict, "user not found")
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doVkLaunchContexts")
+		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, launchContexts)
+}
@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type roleRoutes struct {
+	u usecase.Role
+	l logger.Interface
+}
+
+func newRoleRoutes(handler *gin.RouterGroup, u usecase.Role, l logger.Interface) {
+	routes := &roleRoutes{
+		l: l,
+		u: u,
+	}
+
+	canRead := middlewares.RequirePermission(string(entity.RolesReadPermission))
+	canWrite := middlewares.RequirePermission(string(entity.RolesWritePermission))
+
+	handler.GET("/roles", canRead, routes.doGetRoles)
+	handler.POST("/roles", canWrite, routes.doCreateRole)
+	handler.PUT("/roles/:name", canWrite, routes.doUpdateRole)
+	handler.DELETE("/roles/:name", canWrite, routes.doDeleteRole)
+	handler.GET("/permissions", canRead, routes.doGetPermissions)
+}
+
+// @Summary     Get roles
+// @Description Get all roles with granted permissions
+// @ID          role-list
+// @Tags  	    roles
+// @Accept      json
+// @Success     200 {array} entity.RoleInfo
+// @Failure     403
+// @Failure     500
+// @Produce     json
+// @Router      /admin/roles [get]
+// @Security    BearerAuth
+func (r *roleRoutes) doGetRoles(ctx *gin.Context) {
+	roles, err := r.u.Roles(ctx.Request.Context())
+	if err != nil {
+		r.l.Error(err, "http - v1 - doGetRoles")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, roles)
+}
+
+// @Summary     Get permissions
+// @Description Get all permissions which can be granted to roles
+// @ID          permission-list
+// @Tags  	    roles
+// @Accept      json
+// @Success     200 {array} entity.PermissionInfo
+// @Failure     403
+// @Failure     500
+// @Produce     json
+// @Router      /admin/permissions [get]
+// @Security    BearerAuth
+func (r *roleRoutes) doGetPermissions(ctx *gin.Context) {
+	permissions, err := r.u.Permissions(ctx.Request.Context())
+	if err != nil {
+		r.l.Error(err, "http - v1 - doGetPermissions")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, permissions)
+}
+
+type doCreateRoleRequest struct {
+	Name        string              `json:"name" binding:"required"`
+	Description string              `json:"description"`
+	Permissions []entity.Permission `json:"permissions"`
+}
+
+// @Summary     Create role
+// @Description Create custom role with permissions
+// @ID          role-create
+// @Tags  	    roles
+// @Param 			request body doCreateRoleRequest true "query params"
+// @Accept      json
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     409
+// @Failure     500
+// @Produce     json
+// @Router      /admin/roles [post]
+// @Security    BearerAuth
+func (r *roleRoutes) doCreateRole(ctx *gin.Context) {
+	var request doCreateRoleRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	err := r.u.CreateRole(ctx.Request.Context(), entity.Role(request.Name), request.Description, request.Permissions)
+	if errors.Is(err, entity.ErrBadRole) {
+		errorResponse(ctx, http.StatusBadRequest, "invalid role name")
+
+		return
+	}
+	if errors.Is(err, entity.ErrPermissionNotFound) {
+		errorResponse(ctx, http.StatusBadRequest, "unknown permission")
+
+		return
+	}
+	if errors.Is(err, entity.ErrRoleExists) {
+		errorResponse(ctx, http.StatusConflict, "role already exists")
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doCreateRole")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type doUpdateRoleRequest struct {
+	Description string              `json:"description"`
+	Permissions []entity.Permission `json:"permissions"`
+}
+
+// @Summary     Update role
+// @Description Replace description and permissions of custom role
+// @ID          role-update
+// @Tags  	    roles
+// @Param       name path string true "Role name"
+// @Param 			request body doUpdateRoleRequest true "query params"
+// @Accept      json
+// @Success     200
+// @Failure     400
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /admin/roles/{name} [put]
+// @Security    BearerAuth
+func (r *roleRoutes) doUpdateRole(ctx *gin.Context) {
+	var request doUpdateRoleRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	err := r.u.UpdateRole(ctx.Request.Context(), entity.Role(ctx.Param("name")), request.Description, request.Permissions)
+	if errors.Is(err, entity.ErrPermissionNotFound) {
+		errorResponse(ctx, http.StatusBadRequest, "unknown permission")
+
+		return
+	}
+	if errors.Is(err, entity.ErrRoleBuiltin) {
+		errorResponse(ctx, http.StatusForbidden, "builtin role can't be changed")
+
+		return
+	}
+	if errors.Is(err, entity.ErrRoleNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "role not found")
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doUpdateRole")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// @Summary     Delete role
+// @Description Delete custom role which is not assigned to users
+// @ID          role-delete
+// @Tags  	    roles
+// @Param       name path string true "Role name"
+// @Accept      json
+// @Success     200
+// @Failure     403
+// @Failure     404
+// @Failure     409
+// @Failure     500
+// @Produce     json
+// @Router      /admin/roles/{name} [delete]
+// @Security    BearerAuth
+func (r *roleRoutes) doDeleteRole(ctx *gin.Context) {
+	err := r.u.DeleteRole(ctx.Request.Context(), entity.Role(ctx.Param("name")))
+	if errors.Is(err, entity.ErrRoleBuiltin) {
+		errorResponse(ctx, http.StatusForbidden, "builtin role can't be changed")
+
+		return
+	}
+	if errors.Is(err, entity.ErrRoleNotFound) {
+		errorResponse(ctx, http.StatusNotFound, "role not found")
+
+		return
+	}
+	if errors.Is(err, entity.ErrRoleInUse) {
+		errorResponse(ctx, http.StatusConflict, "role is assigned to users")
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doDeleteRole")
+		errorResponse(ctx, http.StatusInternalServerError, "SSO service problems")
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
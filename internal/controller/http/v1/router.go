@@ -2,8 +2,6 @@
 package v1
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
@@ -12,21 +10,23 @@ import (
 	_ "github.com/VmesteApp/auth-service/docs"
 
 	"github.com/VmesteApp/auth-service/config"
-	"github.com/VmesteApp/auth-service/internal/entity"
 	"github.com/VmesteApp/auth-service/internal/usecase"
+	"github.com/VmesteApp/auth-service/pkg/gateway"
 	"github.com/VmesteApp/auth-service/pkg/logger"
 	"github.com/VmesteApp/auth-service/pkg/middlewares"
+	"github.com/VmesteApp/auth-service/pkg/probe"
 )
 
-func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.User, a usecase.Admin, p usecase.Profile, cfg *config.Config) {
+func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.User, a usecase.Admin, r usecase.Role, au usecase.Audit, p usecase.Profile, g *gateway.Gateway, pr *probe.Probe, cfg *config.Config) {
 	handler.Use(gin.Logger())
 	handler.Use(gin.Recovery())
+	handler.Use(middlewares.RequestMeta())
 
 	// API docs
 	handler.GET("auth/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// K8s probe
-	handler.GET("/auth/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+	// K8s probes
+	newProbeRoutes(handler.Group("/auth"), pr)
 
 	// Prometheus metrics
 	handler.GET("/auth/metrics", gin.WrapH(promhttp.Handler()))
@@ -41,16 +41,24 @@ func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.User, a usecas
 	{
 		h := handler.Group(
 			"/auth/admin",
-			middlewares.AuthMiddleware(cfg.JwtConfig.Secret),
-			middlewares.RoleMiddleware(string(entity.SuperAdminRole)),
+			middlewares.AuthMiddleware(cfg.JwtConfig.Secret, t.TokenValid),
 		)
 
 		newAdminRoutes(h, a, l)
+		newRoleRoutes(h, r, l)
+		newAuditRoutes(h, au, l)
 	}
 
 	{
-		h := handler.Group("/auth/profile", middlewares.AuthMiddleware(cfg.JwtConfig.Secret))
+		h := handler.Group("/auth/profile", middlewares.AuthMiddleware(cfg.JwtConfig.Secret, t.TokenValid))
 
 		newProfileRoutes(h, p, l)
 	}
+
+	{
+		// Auth is checked by gRPC interceptors inside gateway
+		h := handler.Group("/auth/rpc")
+
+		newRPCRoutes(h, g)
+	}
 }
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/VmesteApp/auth-service/pkg/gateway"
+	"github.com/VmesteApp/auth-service/pkg/interceptors"
+)
+
+// rpcRoutes - errors are logged by gRPC interceptors inside gateway.
+type rpcRoutes struct {
+	g *gateway.Gateway
+}
+
+func newRPCRoutes(handler *gin.RouterGroup, g *gateway.Gateway) {
+	r := &rpcRoutes{g}
+
+	handler.POST("/:service/:method", r.doInvoke)
+}
+
+// @Summary     Call gRPC method
+// @Description Call unary method of gRPC service with JSON request (protojson), same auth rules as gRPC API
+// @ID          rpc-invoke
+// @Tags  	    rpc
+// @Param       service  path  string  true  "Service, e.g. auth.v1.UserService"
+// @Param       method   path  string  true  "Method, e.g. GetUser"
+// @Accept      json
+// @Success     200
+// @Failure     400
+// @Failure     401
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /rpc/{service}/{method} [post]
+// @Security    BearerAuth
+func (r *rpcRoutes) doInvoke(ctx *gin.Context) {
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		errorResponse(ctx, http.StatusBadRequest, "invalid request")
+
+		return
+	}
+
+	md := metadata.MD{}
+	if header := ctx.GetHeader("Authorization"); header != "" {
+		md.Set("authorization", header)
+	}
+	if header := ctx.GetHeader(interceptors.RequestIDHeader); header != "" {
+		md.Set(interceptors.RequestIDHeader, header)
+	}
+
+	method := fmt.Sprintf("/%s/%s", ctx.Param("service"), ctx.Param("method"))
+
+	resp, err := r.g.Invoke(metadata.NewIncomingContext(ctx.Request.Context(), md), method, body)
+	if err != nil {
+		st := status.Convert(err)
+		errorResponse(ctx, httpStatusFromCode(st.Code()), st.Message())
+
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/json", resp)
+}
+
+// httpStatusFromCode - same mapping as grpc-gateway.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound, codes.Unimplemented:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -23,6 +23,11 @@ func newUserRoutes(handler *gin.RouterGroup, u usecase.User, l logger.Interface)
 	handler.POST("/login", r.doLoginByEmail)
 	handler.POST("/login/vk", r.doVkLoginByLaunchParams)
 	handler.POST("/login/vk/access-token", r.doVkLoginByAccessToken)
+	handler.GET("/login/vk/authorize", r.doVkAuthorize)
+	handler.GET("/login/vk/callback", r.doVkCallback)
+	handler.POST("/login/telegram", r.doTelegramLogin)
+	handler.GET("/login/oauth/:provider/authorize", r.doOAuthAuthorize)
+	handler.GET("/login/oauth/:provider/callback", r.doOAuthCallback)
 }
 
 type doRegisterNewUserRequest struct {
@@ -88,6 +93,7 @@ type doLoginResponse struct {
 // @Success     200  {object}   doLoginResponse
 // @Failure     400
 // @Failure     401
+// @Failure     403
 // @Failure     409
 // @Failure     500
 // @Produce     json
@@ -112,6 +118,11 @@ func (r *userRoutes) doLoginByEmail(ctx *gin.Context) {
 
 		return
 	}
+	if errors.Is(err, entity.ErrUserSuspended) || errors.Is(err, entity.ErrUserBanned) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
 	if err != nil {
 		r.l.Error(err, "http - v1 - doLoginByEmail")
 		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
@@ -141,7 +152,10 @@ type doLoginByVkAccessTokenRequest struct {
 // @Success     200  {object}  doLoginResponse
 // @Failure     400
 // @Failure     401
+// @Failure     403
 // @Failure     500
+// @Failure     503
+// @Header      503  {integer}  Retry-After  "Seconds until VK API calls are resumed"
 // @Produce     json
 // @Router      /login/vk/access-token [post]
 func (r *userRoutes) doVkLoginByAccessToken(ctx *gin.Context) {
@@ -162,6 +176,15 @@ func (r *userRoutes) doVkLoginByAccessToken(ctx *gin.Context) {
 		errorResponse(ctx, http.StatusUnauthorized, "access_token is expired")
 		return
 	}
+	if errors.Is(err, entity.ErrVkUnavailable) {
+		vkUnavailableResponse(ctx, err)
+		return
+	}
+	if errors.Is(err, entity.ErrUserSuspended) || errors.Is(err, entity.ErrUserBanned) || errors.Is(err, entity.ErrUserDeleted) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
 	if err != nil {
 		r.l.Error(err, "http - v1 - doVkLoginByAccessToken")
 		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
@@ -191,7 +214,9 @@ type doVkLoginByLaunchParamsRequest struct {
 // @Success     200  {object}  doLoginResponse
 // @Failure     400
 // @Failure     401
+// @Failure     403
 // @Failure     500
+// @Failure     503
 // @Produce     json
 // @Router      /login/vk [post]
 func (r *userRoutes) doVkLoginByLaunchParams(ctx *gin.Context) {
@@ -208,6 +233,23 @@ func (r *userRoutes) doVkLoginByLaunchParams(ctx *gin.Context) {
 		errorResponse(ctx, http.StatusBadRequest, "wrong launch params")
 		return
 	}
+	if errors.Is(err, entity.ErrVkLaunchParamsExpired) {
+		errorResponse(ctx, http.StatusUnauthorized, "launch params are expired")
+		return
+	}
+	if errors.Is(err, entity.ErrVkLaunchParamsReplayed) {
+		errorResponse(ctx, http.StatusUnauthorized, "launch params already used")
+		return
+	}
+	if errors.Is(err, entity.ErrTooManyVkLaunches) {
+		errorResponse(ctx, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	if errors.Is(err, entity.ErrUserSuspended) || errors.Is(err, entity.ErrUserBanned) || errors.Is(err, entity.ErrUserDeleted) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
 	if err != nil {
 		r.l.Error(err, "http - v1 - doVkLoginByAccessToken")
 		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
@@ -223,3 +265,70 @@ func (r *userRoutes) doVkLoginByLaunchParams(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, res)
 }
+
+type doTelegramLoginRequest struct {
+	ID        int64  `json:"id" binding:"required"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Username  string `json:"username"`
+	PhotoURL  string `json:"photo_url"`
+	AuthDate  int64  `json:"auth_date" binding:"required"`
+	Hash      string `json:"hash" binding:"required"`
+}
+
+// @Summary     Login by Telegram
+// @Description Login by Telegram Login Widget data for users
+// @ID          login-telegram
+// @Tags  	    login
+// @Param 			request body doTelegramLoginRequest true "query params"
+// @Accept      json
+// @Success     200  {object}  doLoginResponse
+// @Failure     400
+// @Failure     401
+// @Failure     403
+// @Failure     404
+// @Failure     500
+// @Produce     json
+// @Router      /login/telegram [post]
+func (r *userRoutes) doTelegramLogin(ctx *gin.Context) {
+	var request doTelegramLoginRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		r.l.Error(err, "http - v1 - doTelegramLogin")
+		errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	user, token, err := r.u.TelegramLogin(ctx.Request.Context(), entity.TelegramAuthData(request))
+	if errors.Is(err, entity.ErrTelegramDisabled) {
+		errorResponse(ctx, http.StatusNotFound, "telegram login is disabled")
+		return
+	}
+	if errors.Is(err, entity.ErrBadTelegramAuthData) {
+		errorResponse(ctx, http.StatusBadRequest, "wrong telegram auth data")
+		return
+	}
+	if errors.Is(err, entity.ErrTelegramAuthDataExpired) {
+		errorResponse(ctx, http.StatusUnauthorized, "telegram auth data is expired")
+		return
+	}
+	if errors.Is(err, entity.ErrUserSuspended) || errors.Is(err, entity.ErrUserBanned) || errors.Is(err, entity.ErrUserDeleted) {
+		errorResponse(ctx, http.StatusForbidden, err.Error())
+
+		return
+	}
+	if err != nil {
+		r.l.Error(err, "http - v1 - doTelegramLogin")
+		errorResponse(ctx, http.StatusInternalServerError, "auth service problems")
+
+		return
+	}
+
+	res := doLoginResponse{
+		Token:  token,
+		UserID: user.ID,
+		Role:   user.Role,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	LoginAction         AuditAction = "user.login"
+	RoleChangedAction   AuditAction = "user.role_changed"
+	StatusChangedAction AuditAction = "user.status_changed"
+	PasswordResetAction AuditAction = "user.password_reset"
+	AdminCreatedAction  AuditAction = "admin.created"
+	AdminDeletedAction  AuditAction = "admin.deleted"
+	AdminRestoredAction AuditAction = "admin.restored"
+)
+
+type AuditOutcome string
+
+const (
+	SuccessOutcome AuditOutcome = "success"
+	FailureOutcome AuditOutcome = "failure"
+)
+
+// AuditEvent - security relevant action, ActorID is 0 for operator tools and anonymous callers.
+// Hash covers all fields and PrevHash, so events form a chain and any change of the past breaks it.
+type AuditEvent struct {
+	ID        uint64         `json:"id"`
+	ActorID   uint64         `json:"actorId"`
+	TargetID  uint64         `json:"targetId"`
+	Action    AuditAction    `json:"action"`
+	IP        string         `json:"ip,omitempty"`
+	UserAgent string         `json:"userAgent,omitempty"`
+	Outcome   AuditOutcome   `json:"outcome"`
+	Metadata  map[string]any `json:"metadata"`
+	CreatedAt time.Time      `json:"createdAt"`
+	PrevHash  string         `json:"prevHash,omitempty"`
+	Hash      string         `json:"hash,omitempty"`
+}
+
+// ComputeHash - hex HMAC-SHA-256 of event without ID, so the chain can't be rebuilt without key.
+// Metadata must be decoded from JSON to hash the same after reading.
+func (e *AuditEvent) ComputeHash(key []byte) string {
+	data, _ := json.Marshal([]any{ //nolint:errcheck // metadata is decoded JSON
+		e.PrevHash,
+		e.ActorID,
+		e.TargetID,
+		e.Action,
+		e.IP,
+		e.UserAgent,
+		e.Outcome,
+		e.Metadata,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// AuditFilter - search of audit events, zero fields are not applied.
+type AuditFilter struct {
+	ActorID  uint64
+	TargetID uint64
+	Action   AuditAction
+	Outcome  AuditOutcome
+	From     time.Time
+	To       time.Time
+
+	// AfterID - cursor, events with id beyond it in the filter order.
+	AfterID uint64
+	Desc    bool
+	Limit   uint64
+}
+
+type AuditPage struct {
+	Events     []AuditEvent `json:"events"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+// AuditVerification - result of hash chain check, BrokenID is the first event which doesn't match the chain.
+// Skipped are events written before hashing was introduced.
+type AuditVerification struct {
+	Valid    bool   `json:"valid"`
+	Checked  uint64 `json:"checked"`
+	Skipped  uint64 `json:"skipped"`
+	BrokenID uint64 `json:"brokenId,omitempty"`
+}
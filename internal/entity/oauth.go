@@ -0,0 +1,17 @@
+package entity
+
+import "errors"
+
+// OAuthIdentity is a user identity returned by an upstream OAuth2/OIDC provider.
+type OAuthIdentity struct {
+	Provider   string `json:"provider"`
+	ProviderID string `json:"providerId"`
+	Email      string `json:"email,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+var (
+	ErrOAuthProviderNotFound = errors.New("oauth provider not found")
+	ErrBadOAuthState         = errors.New("oauth state is bad")
+	ErrBadOAuthCode          = errors.New("oauth code is bad")
+)
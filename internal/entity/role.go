@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Permission string
+
+const (
+	UsersReadPermission     Permission = "users:read"
+	UsersWritePermission    Permission = "users:write"
+	UsersModeratePermission Permission = "users:moderate"
+	AdminsReadPermission    Permission = "admins:read"
+	AdminsWritePermission   Permission = "admins:write"
+	RolesReadPermission     Permission = "roles:read"
+	RolesWritePermission    Permission = "roles:write"
+	ProfilesReadPermission  Permission = "profiles:read"
+	AuditReadPermission     Permission = "audit:read"
+)
+
+// RoleInfo - role with granted permissions, Version grows on every permissions change.
+type RoleInfo struct {
+	Name        Role         `json:"name"`
+	Description string       `json:"description"`
+	Builtin     bool         `json:"builtin"`
+	Permissions []Permission `json:"permissions"`
+	Version     uint64       `json:"version"`
+	CreatedAt   time.Time    `json:"createdAt"`
+}
+
+type PermissionInfo struct {
+	Name        Permission `json:"name"`
+	Description string     `json:"description"`
+}
+
+var (
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrRoleExists         = errors.New("role exists")
+	ErrRoleBuiltin        = errors.New("builtin role can't be changed")
+	ErrRoleInUse          = errors.New("role is assigned to users")
+	ErrPermissionNotFound = errors.New("permission not found")
+)
@@ -0,0 +1,20 @@
+package entity
+
+import "errors"
+
+// TelegramAuthData is the user object returned by Telegram Login Widget.
+type TelegramAuthData struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Username  string `json:"username"`
+	PhotoURL  string `json:"photo_url"`
+	AuthDate  int64  `json:"auth_date"`
+	Hash      string `json:"hash"`
+}
+
+var (
+	ErrBadTelegramAuthData     = errors.New("telegram auth data is bad")
+	ErrTelegramAuthDataExpired = errors.New("telegram auth data expired")
+	ErrTelegramDisabled        = errors.New("telegram login is disabled")
+)
@@ -2,19 +2,27 @@ package entity
 
 import (
 	"errors"
+	"time"
 )
 
 type User struct {
 	ID           uint64         `json:"id"`
 	Email        string         `json:"email"`
 	Role         Role           `json:"role"`
+	Status       Status         `json:"status"`
+	StatusUntil  *time.Time     `json:"statusUntil,omitempty"`
+	StatusReason string         `json:"statusReason,omitempty"`
 	SocialLogins []*SocialLogin `json:"socialLogins,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
 
-	PassHash []byte
+	PassHash     []byte `json:"-"`
+	TokenVersion uint64 `json:"-"`
 }
 
 type Role string
 
+type Status string
+
 type SocialLogin struct {
 	ID         uint64 `json:"id"`
 	UserID     uint64 `json:"userId"`
@@ -22,14 +30,63 @@ type SocialLogin struct {
 	Provider   string `json:"provider"`
 }
 
+const (
+	VkProvider       = "vk"
+	TelegramProvider = "telegram"
+)
+
 const (
 	UserRole       Role = "user"
 	AdminRole      Role = "admin"
 	SuperAdminRole Role = "superadmin"
+	// ServiceRole - internal service calling gRPC API with mTLS certificate.
+	ServiceRole Role = "service"
 )
 
+const (
+	ActiveStatus Status = "active"
+	// SuspendedStatus - user is blocked till StatusUntil.
+	SuspendedStatus Status = "suspended"
+	// BannedStatus - user is blocked till reactivation.
+	BannedStatus Status = "banned"
+)
+
+// Rank - user may moderate only users with lower rank: user < custom roles < admin, service < superadmin.
+func (r Role) Rank() int {
+	switch r {
+	case UserRole:
+		return 0
+	case AdminRole, ServiceRole:
+		return 2
+	case SuperAdminRole:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// Blocked - banned or suspended user, suspension is over after StatusUntil.
+func (u *User) Blocked(now time.Time) bool {
+	switch u.Status {
+	case BannedStatus:
+		return true
+	case SuspendedStatus:
+		return u.StatusUntil != nil && now.Before(*u.StatusUntil)
+	default:
+		return false
+	}
+}
+
 var (
 	ErrUserNotFound       = errors.New("user not found")
 	ErrUserExists         = errors.New("user exists")
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserSuspended      = errors.New("user is suspended")
+	ErrUserBanned         = errors.New("user is banned")
+	ErrBadStatus          = errors.New("invalid status")
+	ErrSelfAction         = errors.New("action can't be applied to yourself")
+	ErrUserDeleted        = errors.New("user is deleted")
+	ErrProtectedUser      = errors.New("user with the same or higher role can't be moderated")
+	ErrBadRole            = errors.New("unknown role")
+	ErrLastSuperAdmin     = errors.New("last superadmin can't be demoted")
 )
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type UserSort string
+
+const (
+	SortByID        UserSort = "id"
+	SortByCreatedAt UserSort = "created_at"
+)
+
+// UserFilter - search of users, zero fields are not applied.
+type UserFilter struct {
+	Role        Role
+	Provider    string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	EmailPrefix string
+	VkID        int
+
+	Sort  UserSort
+	Desc  bool
+	After *UserCursor
+	Limit uint64
+}
+
+// UserCursor - position of the last user on page in the filter sort order.
+type UserCursor struct {
+	ID        uint64    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type UserPage struct {
+	Users      []*User `json:"users"`
+	NextCursor string  `json:"nextCursor,omitempty"`
+}
+
+var (
+	ErrBadCursor = errors.New("invalid cursor")
+	ErrBadSort   = errors.New("unknown sort")
+)
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// VkLaunchContext is a context of VK Mini App launch, taken from signed launch params.
+type VkLaunchContext struct {
+	Platform                string    `json:"platform"`
+	Language                string    `json:"language"`
+	IsAppUser               bool      `json:"isAppUser"`
+	AreNotificationsEnabled bool      `json:"areNotificationsEnabled"`
+	IsFavorite              bool      `json:"isFavorite"`
+	Ref                     string    `json:"ref,omitempty"`
+	GroupID                 int64     `json:"groupId,omitempty"`
+	ViewerGroupRole         string    `json:"viewerGroupRole,omitempty"`
+	ChatID                  string    `json:"chatId,omitempty"`
+	LaunchedAt              time.Time `json:"launchedAt"`
+}
@@ -1,6 +1,8 @@
 package entity
 
 type VkProfile struct {
-	UserID uint64 `json:"userId"`
-	VkID   int `json:"vkID"`
+	UserID        uint64           `json:"userId"`
+	VkID          int              `json:"vkID"`
+	Info          *VkUserInfo      `json:"info,omitempty"`
+	LaunchContext *VkLaunchContext `json:"launchContext,omitempty"`
 }
@@ -1,6 +1,10 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 type VkTokenInfo struct {
 	Date    int `json:"date"`
@@ -13,4 +17,33 @@ var (
 	ErrVkTokenExpired    = errors.New("vk access_token expired")
 	ErrBadVkToken        = errors.New("vk access_token is bad")
 	ErrBadVkLaunchParams = errors.New("vk launch params is bad")
+
+	ErrVkLaunchParamsExpired  = errors.New("vk launch params expired")
+	ErrVkLaunchParamsReplayed = errors.New("vk launch params already used")
+	ErrTooManyVkLaunches      = errors.New("too many vk launch logins, try later")
 )
+
+var ErrVkUnavailable = errors.New("vk api unavailable")
+
+// VkUnavailableError - VK API calls are suspended by circuit breaker or failed after retries,
+// client may retry after RetryAfter. Err is the last call error, if any.
+type VkUnavailableError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *VkUnavailableError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s, retry after %s: %s", ErrVkUnavailable, e.RetryAfter, e.Err)
+	}
+
+	return fmt.Sprintf("%s, retry after %s", ErrVkUnavailable, e.RetryAfter)
+}
+
+func (e *VkUnavailableError) Is(target error) bool {
+	return target == ErrVkUnavailable
+}
+
+func (e *VkUnavailableError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// VkUserInfo is a public VK user profile fetched by users.get.
+type VkUserInfo struct {
+	VkID       int       `json:"vkID"`
+	FirstName  string    `json:"firstName"`
+	LastName   string    `json:"lastName"`
+	PhotoURL   string    `json:"photoUrl,omitempty"`
+	ScreenName string    `json:"screenName,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
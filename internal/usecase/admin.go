@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
 	"golang.org/x/crypto/bcrypt"
@@ -28,13 +31,17 @@ func (u *AdminUseCase) Admins(ctx context.Context) ([]entity.Admin, error) {
 	return admins, nil
 }
 
-func (u *AdminUseCase) CreateAdmin(ctx context.Context, email, password string) error {
+// CreateAdmin - actorID is 0 for operator tool.
+func (u *AdminUseCase) CreateAdmin(ctx context.Context, actorID uint64, email, password string) error {
 	passHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return fmt.Errorf("can't generate password hash: %w", err)
 	}
 
-	err = u.repo.SaveAdmin(ctx, email, passHash)
+	// target is set by repo to id of new admin
+	event := newAuditEvent(ctx, actorID, 0, entity.AdminCreatedAction, entity.SuccessOutcome, map[string]any{"email": email})
+
+	_, err = u.repo.SaveAdmin(ctx, email, passHash, event)
 	if errors.Is(err, entity.ErrUserExists) {
 		return err
 	}
@@ -45,10 +52,227 @@ func (u *AdminUseCase) CreateAdmin(ctx context.Context, email, password string)
 	return nil
 }
 
-func (u *AdminUseCase) DeleteAdmin(ctx context.Context, userID uint64) error {
-	err := u.repo.DeleteAdmin(ctx, userID)
+// DeleteAdmin - soft deletes admin, it can be restored by RestoreAdmin. actorID is 0 for operator tool.
+func (u *AdminUseCase) DeleteAdmin(ctx context.Context, actorID, userID uint64) error {
+	if actorID == userID {
+		return entity.ErrSelfAction
+	}
+
+	event := newAuditEvent(ctx, actorID, userID, entity.AdminDeletedAction, entity.SuccessOutcome, nil)
+
+	return u.doUpdateUser(u.repo.DeleteAdmin(ctx, userID, event))
+}
+
+func (u *AdminUseCase) RestoreAdmin(ctx context.Context, actorID, userID uint64) error {
+	event := newAuditEvent(ctx, actorID, userID, entity.AdminRestoredAction, entity.SuccessOutcome, nil)
+
+	return u.doUpdateUser(u.repo.RestoreAdmin(ctx, userID, event))
+}
+
+const (
+	_defaultUsersLimit = 50
+	_maxUsersLimit     = 100
+)
+
+// Users - page of users, cursor is opaque token of the previous page or empty for the first one.
+func (u *AdminUseCase) Users(ctx context.Context, filter entity.UserFilter, cursor string) (entity.UserPage, error) {
+	switch filter.Sort {
+	case "":
+		filter.Sort = entity.SortByID
+	case entity.SortByID, entity.SortByCreatedAt:
+	default:
+		return entity.UserPage{}, entity.ErrBadSort
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = _defaultUsersLimit
+	}
+	filter.Limit = min(filter.Limit, _maxUsersLimit)
+
+	if cursor != "" {
+		after, err := decodeUserCursor(cursor)
+		if err != nil {
+			return entity.UserPage{}, err
+		}
+		filter.After = after
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+
+	users, err := u.repo.Users(ctx, filter)
+	if err != nil {
+		return entity.UserPage{}, fmt.Errorf("can't get users: %w", err)
+	}
+
+	page := entity.UserPage{Users: users}
+
+	if uint64(len(users)) > limit {
+		page.Users = users[:limit]
+
+		last := page.Users[limit-1]
+		page.NextCursor = encodeUserCursor(entity.UserCursor{ID: last.ID, CreatedAt: last.CreatedAt})
+	}
+
+	return page, nil
+}
+
+func encodeUserCursor(cursor entity.UserCursor) string {
+	data, _ := json.Marshal(cursor) //nolint:errcheck // struct of plain fields always marshals
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(cursor string) (*entity.UserCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, entity.ErrBadCursor
+	}
+
+	var after entity.UserCursor
+	if err := json.Unmarshal(data, &after); err != nil {
+		return nil, entity.ErrBadCursor
+	}
+
+	return &after, nil
+}
+
+func (u *AdminUseCase) SocialLogins(ctx context.Context, userID uint64) ([]*entity.SocialLogin, error) {
+	if _, err := u.user(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	socialLogins, err := u.repo.SocialLogins(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get social logins: %w", err)
+	}
+
+	return socialLogins, nil
+}
+
+// ResetPassword - revokes issued tokens of user, actorID is 0 when password is reset by operator tool.
+func (u *AdminUseCase) ResetPassword(ctx context.Context, actorID, userID uint64, password string) error {
+	passHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("can't generate password hash: %w", err)
+	}
+
+	event := newAuditEvent(ctx, actorID, userID, entity.PasswordResetAction, entity.SuccessOutcome, nil)
+
+	return u.doUpdateUser(u.repo.SetUserPassword(ctx, userID, passHash, event))
+}
+
+// ChangeRole - actorID is 0 when role is changed by operator tool.
+func (u *AdminUseCase) ChangeRole(ctx context.Context, actorID, userID uint64, role entity.Role) error {
+	// previous role is added by repo as "from"
+	event := newAuditEvent(ctx, actorID, userID, entity.RoleChangedAction, entity.SuccessOutcome, map[string]any{"to": role})
+
+	_, err := u.repo.SetUserRole(ctx, userID, role, event)
+	if errors.Is(err, entity.ErrLastSuperAdmin) {
+		return err
+	}
+
+	return u.doUpdateUser(err)
+}
+
+// ChangeStatus - suspends till until, bans or reactivates user, actorID is 0 for operator tool.
+func (u *AdminUseCase) ChangeStatus(
+	ctx context.Context,
+	actorID, userID uint64,
+	status entity.Status,
+	until *time.Time,
+	reason string,
+) error {
+	switch status {
+	case entity.ActiveStatus:
+		until, reason = nil, ""
+	case entity.SuspendedStatus:
+		if until == nil || !until.After(time.Now()) {
+			return entity.ErrBadStatus
+		}
+	case entity.BannedStatus:
+		until = nil
+	default:
+		return entity.ErrBadStatus
+	}
+
+	if actorID == userID {
+		return entity.ErrSelfAction
+	}
+
+	if err := u.checkModeration(ctx, actorID, userID, status); err != nil {
+		return err
+	}
+
+	// previous status is added by repo as "from"
+	metadata := map[string]any{"to": status}
+	if until != nil {
+		metadata["until"] = until
+	}
+	if reason != "" {
+		metadata["reason"] = reason
+	}
+
+	event := newAuditEvent(ctx, actorID, userID, entity.StatusChangedAction, entity.SuccessOutcome, metadata)
+
+	err := u.repo.SetUserStatus(ctx, actorID, userID, status, until, reason, event)
+	if errors.Is(err, entity.ErrProtectedUser) {
+		return err
+	}
+
+	return u.doUpdateUser(err)
+}
+
+// checkModeration - actor may moderate only users with lower role rank, actorID 0 is operator tool,
+// and superadmin is never blocked. Repo repeats the check in UPDATE against concurrent role change.
+func (u *AdminUseCase) checkModeration(ctx context.Context, actorID, userID uint64, status entity.Status) error {
+	target, err := u.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if target.Role == entity.SuperAdminRole && status != entity.ActiveStatus {
+		return entity.ErrProtectedUser
+	}
+
+	if actorID == 0 {
+		return nil
+	}
+
+	actor, err := u.user(ctx, actorID)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		// deleted actor has no rank at all
+		return entity.ErrProtectedUser
+	}
+	if err != nil {
+		return err
+	}
+
+	if target.Role.Rank() >= actor.Role.Rank() {
+		return entity.ErrProtectedUser
+	}
+
+	return nil
+}
+
+func (u *AdminUseCase) user(ctx context.Context, userID uint64) (*entity.User, error) {
+	user, err := u.repo.UserByID(ctx, userID)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (u *AdminUseCase) doUpdateUser(err error) error {
+	if errors.Is(err, entity.ErrUserNotFound) || errors.Is(err, entity.ErrBadRole) {
+		return err
+	}
 	if err != nil {
-		return fmt.Errorf("can't delete admin: %w", err)
+		return fmt.Errorf("can't update user: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,442 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+)
+
+// storedUsers - repo content sorted by id.
+func storedUsers(n int) []*entity.User {
+	createdAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	users := make([]*entity.User, 0, n)
+	for i := 1; i <= n; i++ {
+		users = append(users, &entity.User{ID: uint64(i), Role: entity.UserRole, CreatedAt: createdAt.Add(time.Duration(i) * time.Minute)})
+	}
+
+	return users
+}
+
+// usersRepo - AdminRepo serving users sorted by id after the cursor, as postgres repo does.
+func usersRepo(ctrl *gomock.Controller, stored []*entity.User, filters *[]entity.UserFilter) *MockAdminRepo {
+	repo := NewMockAdminRepo(ctrl)
+
+	repo.EXPECT().Users(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+			*filters = append(*filters, filter)
+
+			users := make([]*entity.User, 0, filter.Limit)
+			for _, user := range stored {
+				if filter.After != nil && user.ID <= filter.After.ID {
+					continue
+				}
+				if uint64(len(users)) == filter.Limit {
+					break
+				}
+
+				users = append(users, user)
+			}
+
+			return users, nil
+		}).AnyTimes()
+
+	return repo
+}
+
+func TestAdminUsersPages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		stored    int
+		limit     uint64
+		repoLimit uint64
+		pages     []int
+	}{
+		{
+			name:      "empty",
+			limit:     2,
+			repoLimit: 3,
+			pages:     []int{0},
+		},
+		{
+			name:      "single page",
+			stored:    2,
+			limit:     5,
+			repoLimit: 6,
+			pages:     []int{2},
+		},
+		{
+			name:      "exactly full page has no next",
+			stored:    2,
+			limit:     2,
+			repoLimit: 3,
+			pages:     []int{2},
+		},
+		{
+			name:      "last page is partial",
+			stored:    5,
+			limit:     2,
+			repoLimit: 3,
+			pages:     []int{2, 2, 1},
+		},
+		{
+			name:      "last page is full",
+			stored:    4,
+			limit:     2,
+			repoLimit: 3,
+			pages:     []int{2, 2},
+		},
+		{
+			name:      "default limit",
+			stored:    60,
+			repoLimit: 51,
+			pages:     []int{50, 10},
+		},
+		{
+			name:      "limit is capped",
+			stored:    150,
+			limit:     1000,
+			repoLimit: 101,
+			pages:     []int{100, 50},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			var filters []entity.UserFilter
+
+			stored := storedUsers(tc.stored)
+			u := usecase.NewAdminUseCase(usersRepo(ctrl, stored, &filters))
+
+			var (
+				cursor string
+				got    []int
+				seen   []*entity.User
+			)
+
+			for {
+				page, err := u.Users(context.Background(), entity.UserFilter{Limit: tc.limit}, cursor)
+				if err != nil {
+					t.Fatalf("Users: %s", err)
+				}
+
+				got = append(got, len(page.Users))
+				seen = append(seen, page.Users...)
+
+				if page.NextCursor == "" {
+					break
+				}
+				if len(got) > len(tc.pages) {
+					t.Fatalf("pages = %v, want %v", got, tc.pages)
+				}
+
+				cursor = page.NextCursor
+			}
+
+			if len(got) != len(tc.pages) {
+				t.Fatalf("pages = %v, want %v", got, tc.pages)
+			}
+			for i := range got {
+				if got[i] != tc.pages[i] {
+					t.Fatalf("pages = %v, want %v", got, tc.pages)
+				}
+			}
+
+			for i, user := range seen {
+				if user.ID != stored[i].ID {
+					t.Fatalf("user %d has id %d, want %d", i, user.ID, stored[i].ID)
+				}
+			}
+
+			var offset int
+
+			for i, filter := range filters {
+				if filter.Limit != tc.repoLimit {
+					t.Errorf("page %d: repo limit = %d, want %d", i, filter.Limit, tc.repoLimit)
+				}
+				if filter.Sort != entity.SortByID {
+					t.Errorf("page %d: repo sort = %q, want %q", i, filter.Sort, entity.SortByID)
+				}
+
+				if i == 0 {
+					if filter.After != nil {
+						t.Errorf("first page: cursor = %+v, want nil", filter.After)
+					}
+
+					continue
+				}
+
+				// cursor points to the last user of previous page
+				offset += tc.pages[i-1]
+				last := seen[offset-1]
+				if filter.After == nil || filter.After.ID != last.ID || !filter.After.CreatedAt.Equal(last.CreatedAt) {
+					t.Errorf("page %d: cursor = %+v, want id %d created at %s", i, filter.After, last.ID, last.CreatedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestAdminUsersBadRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		filter entity.UserFilter
+		cursor string
+		err    error
+	}{
+		{
+			name:   "cursor isn't base64",
+			cursor: "not a cursor!",
+			err:    entity.ErrBadCursor,
+		},
+		{
+			name:   "padded cursor",
+			cursor: base64.URLEncoding.EncodeToString([]byte(`{"id":1}`)),
+			err:    entity.ErrBadCursor,
+		},
+		{
+			name:   "cursor isn't json",
+			cursor: base64.RawURLEncoding.EncodeToString([]byte("id=1")),
+			err:    entity.ErrBadCursor,
+		},
+		{
+			name:   "cursor of wrong type",
+			cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"id":"1"}`)),
+			err:    entity.ErrBadCursor,
+		},
+		{
+			name:   "unknown sort",
+			filter: entity.UserFilter{Sort: "email"},
+			err:    entity.ErrBadSort,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			// bad request doesn't reach repo
+			u := usecase.NewAdminUseCase(NewMockAdminRepo(ctrl))
+
+			_, err := u.Users(context.Background(), tc.filter, tc.cursor)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Users error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestAdminChangeStatusRanks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		// operator - authctl acting with actorID 0
+		operator  = entity.Role("")
+		moderator = entity.Role("moderator")
+	)
+
+	until := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		actor      entity.Role
+		target     entity.Role
+		status     entity.Status
+		self       bool
+		deleted    bool
+		concurrent bool
+		err        error
+	}{
+		{
+			name:   "admin bans user",
+			actor:  entity.AdminRole,
+			target: entity.UserRole,
+			status: entity.BannedStatus,
+		},
+		{
+			name:   "custom role suspends user",
+			actor:  moderator,
+			target: entity.UserRole,
+			status: entity.SuspendedStatus,
+		},
+		{
+			name:   "custom role can't suspend admin",
+			actor:  moderator,
+			target: entity.AdminRole,
+			status: entity.SuspendedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "user can't ban custom role",
+			actor:  entity.UserRole,
+			target: moderator,
+			status: entity.BannedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "admin can't ban admin",
+			actor:  entity.AdminRole,
+			target: entity.AdminRole,
+			status: entity.BannedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "admin can't ban service",
+			actor:  entity.AdminRole,
+			target: entity.ServiceRole,
+			status: entity.BannedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "superadmin bans admin",
+			actor:  entity.SuperAdminRole,
+			target: entity.AdminRole,
+			status: entity.BannedStatus,
+		},
+		{
+			name:   "operator bans admin",
+			actor:  operator,
+			target: entity.AdminRole,
+			status: entity.BannedStatus,
+		},
+		{
+			name:   "operator can't suspend superadmin",
+			actor:  operator,
+			target: entity.SuperAdminRole,
+			status: entity.SuspendedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "superadmin can't ban superadmin",
+			actor:  entity.SuperAdminRole,
+			target: entity.SuperAdminRole,
+			status: entity.BannedStatus,
+			err:    entity.ErrProtectedUser,
+		},
+		{
+			name:   "operator reactivates superadmin",
+			actor:  operator,
+			target: entity.SuperAdminRole,
+			status: entity.ActiveStatus,
+		},
+		{
+			name:   "self moderation",
+			actor:  entity.SuperAdminRole,
+			target: entity.SuperAdminRole,
+			status: entity.ActiveStatus,
+			self:   true,
+			err:    entity.ErrSelfAction,
+		},
+		{
+			name:    "deleted actor",
+			actor:   entity.AdminRole,
+			target:  entity.UserRole,
+			status:  entity.BannedStatus,
+			deleted: true,
+			err:     entity.ErrProtectedUser,
+		},
+		{
+			name:       "target promoted concurrently",
+			actor:      entity.AdminRole,
+			target:     entity.UserRole,
+			status:     entity.BannedStatus,
+			concurrent: true,
+			err:        entity.ErrProtectedUser,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := NewMockAdminRepo(ctrl)
+
+			var actorID, targetID uint64 = 1, 2
+			if tc.actor == operator {
+				actorID = 0
+			}
+			if tc.self {
+				targetID = actorID
+			}
+
+			users := map[uint64]*entity.User{targetID: {ID: targetID, Role: tc.target}}
+			if actorID != 0 && !tc.deleted {
+				users[actorID] = &entity.User{ID: actorID, Role: tc.actor}
+			}
+
+			repo.EXPECT().UserByID(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, userID uint64) (*entity.User, error) {
+					user, ok := users[userID]
+					if !ok {
+						return nil, entity.ErrUserNotFound
+					}
+
+					return user, nil
+				}).AnyTimes()
+
+			// refused moderation must not reach the update
+			if tc.err == nil || tc.concurrent {
+				var err error
+				if tc.concurrent {
+					err = entity.ErrProtectedUser
+				}
+
+				repo.EXPECT().
+					SetUserStatus(gomock.Any(), actorID, targetID, tc.status, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(err)
+			}
+
+			var statusUntil *time.Time
+			if tc.status == entity.SuspendedStatus {
+				statusUntil = &until
+			}
+
+			err := usecase.NewAdminUseCase(repo).
+				ChangeStatus(context.Background(), actorID, targetID, tc.status, statusUntil, "test")
+			if !errors.Is(err, tc.err) {
+				t.Errorf("ChangeStatus error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestAdminResetPasswordAudited(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := NewMockAdminRepo(ctrl)
+
+	repo.EXPECT().SetUserPassword(gomock.Any(), uint64(2), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, passHash []byte, event entity.AuditEvent) error {
+			if string(passHash) == "new-password" {
+				t.Error("password is saved without hashing")
+			}
+			if event.Action != entity.PasswordResetAction || event.ActorID != 1 || event.TargetID != 2 {
+				t.Errorf("event = %+v, want password reset of 2 by 1", event)
+			}
+
+			return nil
+		})
+
+	if err := usecase.NewAdminUseCase(repo).ResetPassword(context.Background(), 1, 2, "new-password"); err != nil {
+		t.Fatalf("ResetPassword: %s", err)
+	}
+}
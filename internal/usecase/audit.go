@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/reqmeta"
+)
+
+const (
+	_defaultAuditLimit = 50
+	_maxAuditLimit     = 500
+	_verifyBatchSize   = 1000
+)
+
+type AuditUseCase struct {
+	repo AuditRepo
+	key  []byte
+}
+
+// NewAuditUseCase - key is HMAC key of audit log hash chain.
+func NewAuditUseCase(repo AuditRepo, key []byte) *AuditUseCase {
+	return &AuditUseCase{repo: repo, key: key}
+}
+
+// Events - page of events, newest first, cursor is opaque token of the previous page or empty for the first one.
+func (u *AuditUseCase) Events(ctx context.Context, filter entity.AuditFilter, cursor string) (entity.AuditPage, error) {
+	if filter.Limit == 0 {
+		filter.Limit = _defaultAuditLimit
+	}
+	filter.Limit = min(filter.Limit, _maxAuditLimit)
+	filter.Desc = true
+
+	if cursor != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return entity.AuditPage{}, entity.ErrBadCursor
+		}
+
+		filter.AfterID, err = strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return entity.AuditPage{}, entity.ErrBadCursor
+		}
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+
+	events, err := u.repo.AuditEvents(ctx, filter)
+	if err != nil {
+		return entity.AuditPage{}, fmt.Errorf("can't get audit events: %w", err)
+	}
+
+	page := entity.AuditPage{Events: events}
+
+	if uint64(len(events)) > limit {
+		page.Events = events[:limit]
+
+		lastID := page.Events[limit-1].ID
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(lastID, 10)))
+	}
+
+	return page, nil
+}
+
+// Verify - walks the whole log from the oldest event and checks hash chain.
+// Events written before hashing was introduced have no hash and are skipped while they precede the chain,
+// but log of only such events is invalid, as it's what stripping all hashes looks like.
+func (u *AuditUseCase) Verify(ctx context.Context) (entity.AuditVerification, error) {
+	var (
+		result  entity.AuditVerification
+		prev    string
+		chained bool
+	)
+
+	filter := entity.AuditFilter{Limit: _verifyBatchSize}
+
+	for {
+		events, err := u.repo.AuditEvents(ctx, filter)
+		if err != nil {
+			return entity.AuditVerification{}, fmt.Errorf("can't get audit events: %w", err)
+		}
+
+		for i := range events {
+			event := &events[i]
+
+			if event.Hash == "" && !chained {
+				result.Skipped++
+
+				continue
+			}
+			chained = true
+			result.Checked++
+
+			if event.PrevHash != prev || event.Hash != event.ComputeHash(u.key) {
+				result.BrokenID = event.ID
+
+				return result, nil
+			}
+			prev = event.Hash
+		}
+
+		if uint64(len(events)) < filter.Limit {
+			break
+		}
+		filter.AfterID = events[len(events)-1].ID
+	}
+
+	result.Valid = result.Checked > 0 || result.Skipped == 0
+
+	return result, nil
+}
+
+// newAuditEvent - event with client address of the request from ctx.
+func newAuditEvent(
+	ctx context.Context,
+	actorID, targetID uint64,
+	action entity.AuditAction,
+	outcome entity.AuditOutcome,
+	metadata map[string]any,
+) entity.AuditEvent {
+	meta := reqmeta.FromContext(ctx)
+
+	return entity.AuditEvent{
+		ActorID:   actorID,
+		TargetID:  targetID,
+		Action:    action,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		Outcome:   outcome,
+		Metadata:  metadata,
+	}
+}
@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+)
+
+var _auditKey = []byte("audit-secret")
+
+// auditLog - AuditRepo returning events in id order, as the chain is walked by Verify.
+type auditLog []entity.AuditEvent
+
+func (l auditLog) AuditEvents(_ context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	events := make([]entity.AuditEvent, 0, filter.Limit)
+
+	for _, event := range l {
+		if event.ID <= filter.AfterID {
+			continue
+		}
+		if uint64(len(events)) == filter.Limit {
+			break
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// newAuditLog - legacy events without hash followed by chained ones.
+func newAuditLog(legacy, chained int) auditLog {
+	var (
+		l    auditLog
+		prev string
+	)
+
+	createdAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < legacy+chained; i++ {
+		event := entity.AuditEvent{
+			ID:        uint64(i + 1),
+			ActorID:   1,
+			TargetID:  uint64(i + 2),
+			Action:    entity.RoleChangedAction,
+			IP:        "10.0.0.1",
+			UserAgent: "test",
+			Outcome:   entity.SuccessOutcome,
+			Metadata:  map[string]any{"from": "user", "to": "admin"},
+			CreatedAt: createdAt.Add(time.Duration(i) * time.Second),
+		}
+
+		if i >= legacy {
+			event.PrevHash = prev
+			event.Hash = event.ComputeHash(_auditKey)
+			prev = event.Hash
+		}
+
+		l = append(l, event)
+	}
+
+	return l
+}
+
+func TestAuditVerify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		log    auditLog
+		key    []byte
+		tamper func(l auditLog) auditLog
+		want   entity.AuditVerification
+	}{
+		{
+			name: "empty log",
+			log:  newAuditLog(0, 0),
+			want: entity.AuditVerification{Valid: true},
+		},
+		{
+			name: "valid chain",
+			log:  newAuditLog(0, 5),
+			want: entity.AuditVerification{Valid: true, Checked: 5},
+		},
+		{
+			name: "chain longer than verify batch",
+			log:  newAuditLog(0, 2500),
+			want: entity.AuditVerification{Valid: true, Checked: 2500},
+		},
+		{
+			name: "legacy events before chain",
+			log:  newAuditLog(2, 3),
+			want: entity.AuditVerification{Valid: true, Checked: 3, Skipped: 2},
+		},
+		{
+			name: "only unhashed events",
+			log:  newAuditLog(3, 0),
+			want: entity.AuditVerification{Valid: false, Skipped: 3},
+		},
+		{
+			name: "another key",
+			log:  newAuditLog(0, 3),
+			key:  []byte("another-secret"),
+			want: entity.AuditVerification{Checked: 1, BrokenID: 1},
+		},
+		{
+			name: "changed metadata",
+			log:  newAuditLog(0, 5),
+			tamper: func(l auditLog) auditLog {
+				l[2].Metadata = map[string]any{"from": "user", "to": "superadmin"}
+
+				return l
+			},
+			want: entity.AuditVerification{Checked: 3, BrokenID: 3},
+		},
+		{
+			name: "changed actor",
+			log:  newAuditLog(0, 5),
+			tamper: func(l auditLog) auditLog {
+				l[3].ActorID = 42
+
+				return l
+			},
+			want: entity.AuditVerification{Checked: 4, BrokenID: 4},
+		},
+		{
+			name: "rehashed event without key",
+			log:  newAuditLog(0, 5),
+			tamper: func(l auditLog) auditLog {
+				l[1].IP = "192.168.0.1"
+				l[1].Hash = l[1].ComputeHash(nil)
+
+				return l
+			},
+			want: entity.AuditVerification{Checked: 2, BrokenID: 2},
+		},
+		{
+			name: "deleted event",
+			log:  newAuditLog(0, 5),
+			tamper: func(l auditLog) auditLog {
+				return append(l[:2], l[3:]...)
+			},
+			want: entity.AuditVerification{Checked: 3, BrokenID: 4},
+		},
+		{
+			name: "stripped hash in the middle",
+			log:  newAuditLog(0, 5),
+			tamper: func(l auditLog) auditLog {
+				l[2].PrevHash, l[2].Hash = "", ""
+
+				return l
+			},
+			want: entity.AuditVerification{Checked: 3, BrokenID: 3},
+		},
+		{
+			name: "stripped hash of the first chained event",
+			log:  newAuditLog(1, 4),
+			tamper: func(l auditLog) auditLog {
+				l[1].PrevHash, l[1].Hash = "", ""
+
+				return l
+			},
+			want: entity.AuditVerification{Checked: 1, Skipped: 2, BrokenID: 3},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			log := tc.log
+			if tc.tamper != nil {
+				log = tc.tamper(log)
+			}
+
+			key := _auditKey
+			if tc.key != nil {
+				key = tc.key
+			}
+
+			got, err := usecase.NewAuditUseCase(log, key).Verify(context.Background())
+			if err != nil {
+				t.Fatalf("Verify: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("Verify = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
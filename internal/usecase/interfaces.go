@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"net/url"
+	"time"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
 )
@@ -13,15 +15,31 @@ type (
 		Login(ctx context.Context, email, password string) (*entity.User, string, error)
 		VkLogin(ctx context.Context, vkLaunchParams string) (*entity.User, string, error)
 		VkLoginByAccessToken(ctx context.Context, userAccessToken string) (*entity.User, string, error)
+		TelegramLogin(ctx context.Context, data entity.TelegramAuthData) (*entity.User, string, error)
+		OAuthAuthorize(provider string) (string, string, error)
+		OAuthLogin(ctx context.Context, provider, stateToken string, params url.Values) (*entity.User, string, error)
+		TokenValid(ctx context.Context, userID, tokenVersion, permsVersion uint64) (bool, error)
 	}
 	UserRepo interface {
 		SaveUser(ctx context.Context, email string, hassPash []byte) error
 		User(ctx context.Context, email string) (*entity.User, error)
 		SaveSocialUser(ctx context.Context, provider, providerID string) (*entity.User, error)
 		SocialUser(ctx context.Context, provider, providerID string) (*entity.User, error)
+		SaveVkLaunchContext(ctx context.Context, userID uint64, launchContext entity.VkLaunchContext) error
+		VkUserInfo(ctx context.Context, userID uint64) (*entity.VkUserInfo, error)
+		SaveVkUserInfo(ctx context.Context, userID uint64, info entity.VkUserInfo) error
+		Role(ctx context.Context, name entity.Role) (entity.RoleInfo, error)
+		UserByID(ctx context.Context, userID uint64) (*entity.User, error)
+		SaveAuditEvent(ctx context.Context, event entity.AuditEvent) error
 	}
 	VkWebApi interface {
-		ValidateUserAccessToken(userAccessToken string) (*entity.VkTokenInfo, error)
+		ValidateUserAccessToken(ctx context.Context, userAccessToken string) (*entity.VkTokenInfo, error)
+		User(ctx context.Context, vkID int) (*entity.VkUserInfo, error)
+	}
+	OAuthProvider interface {
+		ID() string
+		AuthCodeURL(state, codeVerifier string) string
+		Exchange(ctx context.Context, code, codeVerifier string, params url.Values) (*entity.OAuthIdentity, error)
 	}
 )
 
@@ -29,21 +47,70 @@ type (
 type (
 	Admin interface {
 		Admins(ctx context.Context) ([]entity.Admin, error)
-		CreateAdmin(ctx context.Context, email, password string) error
-		DeleteAdmin(ctx context.Context, userID uint64) error
+		CreateAdmin(ctx context.Context, actorID uint64, email, password string) error
+		DeleteAdmin(ctx context.Context, actorID, userID uint64) error
+		RestoreAdmin(ctx context.Context, actorID, userID uint64) error
+		ChangeRole(ctx context.Context, actorID, userID uint64, role entity.Role) error
+		Users(ctx context.Context, filter entity.UserFilter, cursor string) (entity.UserPage, error)
+		ChangeStatus(ctx context.Context, actorID, userID uint64, status entity.Status, until *time.Time, reason string) error
 	}
 	AdminRepo interface {
 		Admins(ctx context.Context) ([]entity.Admin, error)
-		SaveAdmin(ctx context.Context, email string, passHash []byte) error
-		DeleteAdmin(ctx context.Context, userID uint64) error
+		SaveAdmin(ctx context.Context, email string, passHash []byte, event entity.AuditEvent) (uint64, error)
+		DeleteAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error
+		RestoreAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error
+		UserByID(ctx context.Context, userID uint64) (*entity.User, error)
+		Users(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+		SocialLogins(ctx context.Context, userID uint64) ([]*entity.SocialLogin, error)
+		SetUserPassword(ctx context.Context, userID uint64, passHash []byte, event entity.AuditEvent) error
+		SetUserRole(ctx context.Context, userID uint64, role entity.Role, event entity.AuditEvent) (entity.Role, error)
+		SetUserStatus(
+			ctx context.Context,
+			actorID, userID uint64,
+			status entity.Status,
+			until *time.Time,
+			reason string,
+			event entity.AuditEvent,
+		) error
+	}
+)
+
+// Audit Routes
+type (
+	Audit interface {
+		Events(ctx context.Context, filter entity.AuditFilter, cursor string) (entity.AuditPage, error)
+		Verify(ctx context.Context) (entity.AuditVerification, error)
+	}
+	AuditRepo interface {
+		AuditEvents(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error)
+	}
+)
+
+// Role Routes
+type (
+	Role interface {
+		Roles(ctx context.Context) ([]entity.RoleInfo, error)
+		Permissions(ctx context.Context) ([]entity.PermissionInfo, error)
+		CreateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error
+		UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error
+		DeleteRole(ctx context.Context, name entity.Role) error
+	}
+	RoleRepo interface {
+		Roles(ctx context.Context) ([]entity.RoleInfo, error)
+		Permissions(ctx context.Context) ([]entity.PermissionInfo, error)
+		SaveRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error
+		UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error
+		DeleteRole(ctx context.Context, name entity.Role) error
 	}
 )
 
 type (
 	Profile interface {
 		VkProfile(ctx context.Context, userID uint64) (entity.VkProfile, error)
+		VkLaunchContexts(ctx context.Context, userID uint64, limit uint64) ([]entity.VkLaunchContext, error)
 	}
 	ProfileRepo interface {
 		VkProfile(ctx context.Context, userID uint64) (entity.VkProfile, error)
+		VkLaunchContexts(ctx context.Context, userID uint64, limit uint64) ([]entity.VkLaunchContext, error)
 	}
 )
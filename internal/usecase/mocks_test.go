@@ -6,8 +6,11 @@ package usecase_test
 
 import (
 	context "context"
+	url "net/url"
 	reflect "reflect"
+	time "time"
 
+	entity "github.com/VmesteApp/auth-service/internal/entity"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,27 +38,128 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 }
 
 // CreateAccount mocks base method.
-func (m *MockUser) CreateAccount(ctx context.Context) {
+func (m *MockUser) CreateAccount(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "CreateAccount", ctx)
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockUserMockRecorder) CreateAccount(ctx interface{}) *gomock.Call {
+func (mr *MockUserMockRecorder) CreateAccount(ctx, email, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockUser)(nil).CreateAccount), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockUser)(nil).CreateAccount), ctx, email, password)
 }
 
 // Login mocks base method.
-func (m *MockUser) Login(ctx context.Context) {
+func (m *MockUser) Login(ctx context.Context, email, password string) (*entity.User, string, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Login", ctx)
+	ret := m.ctrl.Call(m, "Login", ctx, email, password)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockUserMockRecorder) Login(ctx interface{}) *gomock.Call {
+func (mr *MockUserMockRecorder) Login(ctx, email, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUser)(nil).Login), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUser)(nil).Login), ctx, email, password)
+}
+
+// OAuthAuthorize mocks base method.
+func (m *MockUser) OAuthAuthorize(provider string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthAuthorize", provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OAuthAuthorize indicates an expected call of OAuthAuthorize.
+func (mr *MockUserMockRecorder) OAuthAuthorize(provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthAuthorize", reflect.TypeOf((*MockUser)(nil).OAuthAuthorize), provider)
+}
+
+// OAuthLogin mocks base method.
+func (m *MockUser) OAuthLogin(ctx context.Context, provider, stateToken string, params url.Values) (*entity.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthLogin", ctx, provider, stateToken, params)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OAuthLogin indicates an expected call of OAuthLogin.
+func (mr *MockUserMockRecorder) OAuthLogin(ctx, provider, stateToken, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthLogin", reflect.TypeOf((*MockUser)(nil).OAuthLogin), ctx, provider, stateToken, params)
+}
+
+// TelegramLogin mocks base method.
+func (m *MockUser) TelegramLogin(ctx context.Context, data entity.TelegramAuthData) (*entity.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TelegramLogin", ctx, data)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TelegramLogin indicates an expected call of TelegramLogin.
+func (mr *MockUserMockRecorder) TelegramLogin(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TelegramLogin", reflect.TypeOf((*MockUser)(nil).TelegramLogin), ctx, data)
+}
+
+// TokenValid mocks base method.
+func (m *MockUser) TokenValid(ctx context.Context, userID, tokenVersion, permsVersion uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenValid", ctx, userID, tokenVersion, permsVersion)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TokenValid indicates an expected call of TokenValid.
+func (mr *MockUserMockRecorder) TokenValid(ctx, userID, tokenVersion, permsVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValid", reflect.TypeOf((*MockUser)(nil).TokenValid), ctx, userID, tokenVersion, permsVersion)
+}
+
+// VkLogin mocks base method.
+func (m *MockUser) VkLogin(ctx context.Context, vkLaunchParams string) (*entity.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkLogin", ctx, vkLaunchParams)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VkLogin indicates an expected call of VkLogin.
+func (mr *MockUserMockRecorder) VkLogin(ctx, vkLaunchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkLogin", reflect.TypeOf((*MockUser)(nil).VkLogin), ctx, vkLaunchParams)
+}
+
+// VkLoginByAccessToken mocks base method.
+func (m *MockUser) VkLoginByAccessToken(ctx context.Context, userAccessToken string) (*entity.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkLoginByAccessToken", ctx, userAccessToken)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VkLoginByAccessToken indicates an expected call of VkLoginByAccessToken.
+func (mr *MockUserMockRecorder) VkLoginByAccessToken(ctx, userAccessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkLoginByAccessToken", reflect.TypeOf((*MockUser)(nil).VkLoginByAccessToken), ctx, userAccessToken)
 }
 
 // MockUserRepo is a mock of UserRepo interface.
@@ -80,3 +184,947 @@ func NewMockUserRepo(ctrl *gomock.Controller) *MockUserRepo {
 func (m *MockUserRepo) EXPECT() *MockUserRepoMockRecorder {
 	return m.recorder
 }
+
+// Role mocks base method.
+func (m *MockUserRepo) Role(ctx context.Context, name entity.Role) (entity.RoleInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role", ctx, name)
+	ret0, _ := ret[0].(entity.RoleInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockUserRepoMockRecorder) Role(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockUserRepo)(nil).Role), ctx, name)
+}
+
+// SaveAuditEvent mocks base method.
+func (m *MockUserRepo) SaveAuditEvent(ctx context.Context, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditEvent indicates an expected call of SaveAuditEvent.
+func (mr *MockUserRepoMockRecorder) SaveAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditEvent", reflect.TypeOf((*MockUserRepo)(nil).SaveAuditEvent), ctx, event)
+}
+
+// SaveSocialUser mocks base method.
+func (m *MockUserRepo) SaveSocialUser(ctx context.Context, provider, providerID string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSocialUser", ctx, provider, providerID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSocialUser indicates an expected call of SaveSocialUser.
+func (mr *MockUserRepoMockRecorder) SaveSocialUser(ctx, provider, providerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSocialUser", reflect.TypeOf((*MockUserRepo)(nil).SaveSocialUser), ctx, provider, providerID)
+}
+
+// SaveUser mocks base method.
+func (m *MockUserRepo) SaveUser(ctx context.Context, email string, hassPash []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUser", ctx, email, hassPash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUser indicates an expected call of SaveUser.
+func (mr *MockUserRepoMockRecorder) SaveUser(ctx, email, hassPash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUser", reflect.TypeOf((*MockUserRepo)(nil).SaveUser), ctx, email, hassPash)
+}
+
+// SaveVkLaunchContext mocks base method.
+func (m *MockUserRepo) SaveVkLaunchContext(ctx context.Context, userID uint64, launchContext entity.VkLaunchContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVkLaunchContext", ctx, userID, launchContext)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVkLaunchContext indicates an expected call of SaveVkLaunchContext.
+func (mr *MockUserRepoMockRecorder) SaveVkLaunchContext(ctx, userID, launchContext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVkLaunchContext", reflect.TypeOf((*MockUserRepo)(nil).SaveVkLaunchContext), ctx, userID, launchContext)
+}
+
+// SaveVkUserInfo mocks base method.
+func (m *MockUserRepo) SaveVkUserInfo(ctx context.Context, userID uint64, info entity.VkUserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVkUserInfo", ctx, userID, info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVkUserInfo indicates an expected call of SaveVkUserInfo.
+func (mr *MockUserRepoMockRecorder) SaveVkUserInfo(ctx, userID, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVkUserInfo", reflect.TypeOf((*MockUserRepo)(nil).SaveVkUserInfo), ctx, userID, info)
+}
+
+// SocialUser mocks base method.
+func (m *MockUserRepo) SocialUser(ctx context.Context, provider, providerID string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SocialUser", ctx, provider, providerID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SocialUser indicates an expected call of SocialUser.
+func (mr *MockUserRepoMockRecorder) SocialUser(ctx, provider, providerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SocialUser", reflect.TypeOf((*MockUserRepo)(nil).SocialUser), ctx, provider, providerID)
+}
+
+// User mocks base method.
+func (m *MockUserRepo) User(ctx context.Context, email string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "User", ctx, email)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// User indicates an expected call of User.
+func (mr *MockUserRepoMockRecorder) User(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockUserRepo)(nil).User), ctx, email)
+}
+
+// UserByID mocks base method.
+func (m *MockUserRepo) UserByID(ctx context.Context, userID uint64) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByID indicates an expected call of UserByID.
+func (mr *MockUserRepoMockRecorder) UserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByID", reflect.TypeOf((*MockUserRepo)(nil).UserByID), ctx, userID)
+}
+
+// VkUserInfo mocks base method.
+func (m *MockUserRepo) VkUserInfo(ctx context.Context, userID uint64) (*entity.VkUserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkUserInfo", ctx, userID)
+	ret0, _ := ret[0].(*entity.VkUserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkUserInfo indicates an expected call of VkUserInfo.
+func (mr *MockUserRepoMockRecorder) VkUserInfo(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkUserInfo", reflect.TypeOf((*MockUserRepo)(nil).VkUserInfo), ctx, userID)
+}
+
+// MockVkWebApi is a mock of VkWebApi interface.
+type MockVkWebApi struct {
+	ctrl     *gomock.Controller
+	recorder *MockVkWebApiMockRecorder
+}
+
+// MockVkWebApiMockRecorder is the mock recorder for MockVkWebApi.
+type MockVkWebApiMockRecorder struct {
+	mock *MockVkWebApi
+}
+
+// NewMockVkWebApi creates a new mock instance.
+func NewMockVkWebApi(ctrl *gomock.Controller) *MockVkWebApi {
+	mock := &MockVkWebApi{ctrl: ctrl}
+	mock.recorder = &MockVkWebApiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVkWebApi) EXPECT() *MockVkWebApiMockRecorder {
+	return m.recorder
+}
+
+// User mocks base method.
+func (m *MockVkWebApi) User(ctx context.Context, vkID int) (*entity.VkUserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "User", ctx, vkID)
+	ret0, _ := ret[0].(*entity.VkUserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// User indicates an expected call of User.
+func (mr *MockVkWebApiMockRecorder) User(ctx, vkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockVkWebApi)(nil).User), ctx, vkID)
+}
+
+// ValidateUserAccessToken mocks base method.
+func (m *MockVkWebApi) ValidateUserAccessToken(ctx context.Context, userAccessToken string) (*entity.VkTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUserAccessToken", ctx, userAccessToken)
+	ret0, _ := ret[0].(*entity.VkTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateUserAccessToken indicates an expected call of ValidateUserAccessToken.
+func (mr *MockVkWebApiMockRecorder) ValidateUserAccessToken(ctx, userAccessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserAccessToken", reflect.TypeOf((*MockVkWebApi)(nil).ValidateUserAccessToken), ctx, userAccessToken)
+}
+
+// MockOAuthProvider is a mock of OAuthProvider interface.
+type MockOAuthProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthProviderMockRecorder
+}
+
+// MockOAuthProviderMockRecorder is the mock recorder for MockOAuthProvider.
+type MockOAuthProviderMockRecorder struct {
+	mock *MockOAuthProvider
+}
+
+// NewMockOAuthProvider creates a new mock instance.
+func NewMockOAuthProvider(ctrl *gomock.Controller) *MockOAuthProvider {
+	mock := &MockOAuthProvider{ctrl: ctrl}
+	mock.recorder = &MockOAuthProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthProvider) EXPECT() *MockOAuthProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockOAuthProvider) AuthCodeURL(state, codeVerifier string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", state, codeVerifier)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockOAuthProviderMockRecorder) AuthCodeURL(state, codeVerifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockOAuthProvider)(nil).AuthCodeURL), state, codeVerifier)
+}
+
+// Exchange mocks base method.
+func (m *MockOAuthProvider) Exchange(ctx context.Context, code, codeVerifier string, params url.Values) (*entity.OAuthIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, codeVerifier, params)
+	ret0, _ := ret[0].(*entity.OAuthIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockOAuthProviderMockRecorder) Exchange(ctx, code, codeVerifier, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockOAuthProvider)(nil).Exchange), ctx, code, codeVerifier, params)
+}
+
+// ID mocks base method.
+func (m *MockOAuthProvider) ID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockOAuthProviderMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockOAuthProvider)(nil).ID))
+}
+
+// MockAdmin is a mock of Admin interface.
+type MockAdmin struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminMockRecorder
+}
+
+// MockAdminMockRecorder is the mock recorder for MockAdmin.
+type MockAdminMockRecorder struct {
+	mock *MockAdmin
+}
+
+// NewMockAdmin creates a new mock instance.
+func NewMockAdmin(ctrl *gomock.Controller) *MockAdmin {
+	mock := &MockAdmin{ctrl: ctrl}
+	mock.recorder = &MockAdminMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmin) EXPECT() *MockAdminMockRecorder {
+	return m.recorder
+}
+
+// Admins mocks base method.
+func (m *MockAdmin) Admins(ctx context.Context) ([]entity.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admins", ctx)
+	ret0, _ := ret[0].([]entity.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Admins indicates an expected call of Admins.
+func (mr *MockAdminMockRecorder) Admins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admins", reflect.TypeOf((*MockAdmin)(nil).Admins), ctx)
+}
+
+// ChangeRole mocks base method.
+func (m *MockAdmin) ChangeRole(ctx context.Context, actorID, userID uint64, role entity.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRole", ctx, actorID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeRole indicates an expected call of ChangeRole.
+func (mr *MockAdminMockRecorder) ChangeRole(ctx, actorID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRole", reflect.TypeOf((*MockAdmin)(nil).ChangeRole), ctx, actorID, userID, role)
+}
+
+// ChangeStatus mocks base method.
+func (m *MockAdmin) ChangeStatus(ctx context.Context, actorID, userID uint64, status entity.Status, until *time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, actorID, userID, status, until, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockAdminMockRecorder) ChangeStatus(ctx, actorID, userID, status, until, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockAdmin)(nil).ChangeStatus), ctx, actorID, userID, status, until, reason)
+}
+
+// CreateAdmin mocks base method.
+func (m *MockAdmin) CreateAdmin(ctx context.Context, actorID uint64, email, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", ctx, actorID, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockAdminMockRecorder) CreateAdmin(ctx, actorID, email, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockAdmin)(nil).CreateAdmin), ctx, actorID, email, password)
+}
+
+// DeleteAdmin mocks base method.
+func (m *MockAdmin) DeleteAdmin(ctx context.Context, actorID, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *MockAdminMockRecorder) DeleteAdmin(ctx, actorID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockAdmin)(nil).DeleteAdmin), ctx, actorID, userID)
+}
+
+// RestoreAdmin mocks base method.
+func (m *MockAdmin) RestoreAdmin(ctx context.Context, actorID, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAdmin", ctx, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAdmin indicates an expected call of RestoreAdmin.
+func (mr *MockAdminMockRecorder) RestoreAdmin(ctx, actorID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAdmin", reflect.TypeOf((*MockAdmin)(nil).RestoreAdmin), ctx, actorID, userID)
+}
+
+// Users mocks base method.
+func (m *MockAdmin) Users(ctx context.Context, filter entity.UserFilter, cursor string) (entity.UserPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users", ctx, filter, cursor)
+	ret0, _ := ret[0].(entity.UserPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockAdminMockRecorder) Users(ctx, filter, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockAdmin)(nil).Users), ctx, filter, cursor)
+}
+
+// MockAdminRepo is a mock of AdminRepo interface.
+type MockAdminRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminRepoMockRecorder
+}
+
+// MockAdminRepoMockRecorder is the mock recorder for MockAdminRepo.
+type MockAdminRepoMockRecorder struct {
+	mock *MockAdminRepo
+}
+
+// NewMockAdminRepo creates a new mock instance.
+func NewMockAdminRepo(ctrl *gomock.Controller) *MockAdminRepo {
+	mock := &MockAdminRepo{ctrl: ctrl}
+	mock.recorder = &MockAdminRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminRepo) EXPECT() *MockAdminRepoMockRecorder {
+	return m.recorder
+}
+
+// Admins mocks base method.
+func (m *MockAdminRepo) Admins(ctx context.Context) ([]entity.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admins", ctx)
+	ret0, _ := ret[0].([]entity.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Admins indicates an expected call of Admins.
+func (mr *MockAdminRepoMockRecorder) Admins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admins", reflect.TypeOf((*MockAdminRepo)(nil).Admins), ctx)
+}
+
+// DeleteAdmin mocks base method.
+func (m *MockAdminRepo) DeleteAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *MockAdminRepoMockRecorder) DeleteAdmin(ctx, userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockAdminRepo)(nil).DeleteAdmin), ctx, userID, event)
+}
+
+// RestoreAdmin mocks base method.
+func (m *MockAdminRepo) RestoreAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAdmin", ctx, userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAdmin indicates an expected call of RestoreAdmin.
+func (mr *MockAdminRepoMockRecorder) RestoreAdmin(ctx, userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAdmin", reflect.TypeOf((*MockAdminRepo)(nil).RestoreAdmin), ctx, userID, event)
+}
+
+// SaveAdmin mocks base method.
+func (m *MockAdminRepo) SaveAdmin(ctx context.Context, email string, passHash []byte, event entity.AuditEvent) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAdmin", ctx, email, passHash, event)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAdmin indicates an expected call of SaveAdmin.
+func (mr *MockAdminRepoMockRecorder) SaveAdmin(ctx, email, passHash, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAdmin", reflect.TypeOf((*MockAdminRepo)(nil).SaveAdmin), ctx, email, passHash, event)
+}
+
+// SetUserPassword mocks base method.
+func (m *MockAdminRepo) SetUserPassword(ctx context.Context, userID uint64, passHash []byte, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPassword", ctx, userID, passHash, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPassword indicates an expected call of SetUserPassword.
+func (mr *MockAdminRepoMockRecorder) SetUserPassword(ctx, userID, passHash, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPassword", reflect.TypeOf((*MockAdminRepo)(nil).SetUserPassword), ctx, userID, passHash, event)
+}
+
+// SetUserRole mocks base method.
+func (m *MockAdminRepo) SetUserRole(ctx context.Context, userID uint64, role entity.Role, event entity.AuditEvent) (entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, userID, role, event)
+	ret0, _ := ret[0].(entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockAdminRepoMockRecorder) SetUserRole(ctx, userID, role, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockAdminRepo)(nil).SetUserRole), ctx, userID, role, event)
+}
+
+// SetUserStatus mocks base method.
+func (m *MockAdminRepo) SetUserStatus(ctx context.Context, actorID, userID uint64, status entity.Status, until *time.Time, reason string, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserStatus", ctx, actorID, userID, status, until, reason, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserStatus indicates an expected call of SetUserStatus.
+func (mr *MockAdminRepoMockRecorder) SetUserStatus(ctx, actorID, userID, status, until, reason, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserStatus", reflect.TypeOf((*MockAdminRepo)(nil).SetUserStatus), ctx, actorID, userID, status, until, reason, event)
+}
+
+// SocialLogins mocks base method.
+func (m *MockAdminRepo) SocialLogins(ctx context.Context, userID uint64) ([]*entity.SocialLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SocialLogins", ctx, userID)
+	ret0, _ := ret[0].([]*entity.SocialLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SocialLogins indicates an expected call of SocialLogins.
+func (mr *MockAdminRepoMockRecorder) SocialLogins(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SocialLogins", reflect.TypeOf((*MockAdminRepo)(nil).SocialLogins), ctx, userID)
+}
+
+// UserByID mocks base method.
+func (m *MockAdminRepo) UserByID(ctx context.Context, userID uint64) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByID indicates an expected call of UserByID.
+func (mr *MockAdminRepoMockRecorder) UserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByID", reflect.TypeOf((*MockAdminRepo)(nil).UserByID), ctx, userID)
+}
+
+// Users mocks base method.
+func (m *MockAdminRepo) Users(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users", ctx, filter)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockAdminRepoMockRecorder) Users(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockAdminRepo)(nil).Users), ctx, filter)
+}
+
+// MockAudit is a mock of Audit interface.
+type MockAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditMockRecorder
+}
+
+// MockAuditMockRecorder is the mock recorder for MockAudit.
+type MockAuditMockRecorder struct {
+	mock *MockAudit
+}
+
+// NewMockAudit creates a new mock instance.
+func NewMockAudit(ctrl *gomock.Controller) *MockAudit {
+	mock := &MockAudit{ctrl: ctrl}
+	mock.recorder = &MockAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAudit) EXPECT() *MockAuditMockRecorder {
+	return m.recorder
+}
+
+// Events mocks base method.
+func (m *MockAudit) Events(ctx context.Context, filter entity.AuditFilter, cursor string) (entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", ctx, filter, cursor)
+	ret0, _ := ret[0].(entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockAuditMockRecorder) Events(ctx, filter, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockAudit)(nil).Events), ctx, filter, cursor)
+}
+
+// Verify mocks base method.
+func (m *MockAudit) Verify(ctx context.Context) (entity.AuditVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx)
+	ret0, _ := ret[0].(entity.AuditVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockAuditMockRecorder) Verify(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAudit)(nil).Verify), ctx)
+}
+
+// MockAuditRepo is a mock of AuditRepo interface.
+type MockAuditRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepoMockRecorder
+}
+
+// MockAuditRepoMockRecorder is the mock recorder for MockAuditRepo.
+type MockAuditRepoMockRecorder struct {
+	mock *MockAuditRepo
+}
+
+// NewMockAuditRepo creates a new mock instance.
+func NewMockAuditRepo(ctrl *gomock.Controller) *MockAuditRepo {
+	mock := &MockAuditRepo{ctrl: ctrl}
+	mock.recorder = &MockAuditRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepo) EXPECT() *MockAuditRepoMockRecorder {
+	return m.recorder
+}
+
+// AuditEvents mocks base method.
+func (m *MockAuditRepo) AuditEvents(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEvents", ctx, filter)
+	ret0, _ := ret[0].([]entity.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditEvents indicates an expected call of AuditEvents.
+func (mr *MockAuditRepoMockRecorder) AuditEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEvents", reflect.TypeOf((*MockAuditRepo)(nil).AuditEvents), ctx, filter)
+}
+
+// MockRole is a mock of Role interface.
+type MockRole struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleMockRecorder
+}
+
+// MockRoleMockRecorder is the mock recorder for MockRole.
+type MockRoleMockRecorder struct {
+	mock *MockRole
+}
+
+// NewMockRole creates a new mock instance.
+func NewMockRole(ctrl *gomock.Controller) *MockRole {
+	mock := &MockRole{ctrl: ctrl}
+	mock.recorder = &MockRoleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRole) EXPECT() *MockRoleMockRecorder {
+	return m.recorder
+}
+
+// CreateRole mocks base method.
+func (m *MockRole) CreateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, name, description, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockRoleMockRecorder) CreateRole(ctx, name, description, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRole)(nil).CreateRole), ctx, name, description, permissions)
+}
+
+// DeleteRole mocks base method.
+func (m *MockRole) DeleteRole(ctx context.Context, name entity.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockRoleMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRole)(nil).DeleteRole), ctx, name)
+}
+
+// Permissions mocks base method.
+func (m *MockRole) Permissions(ctx context.Context) ([]entity.PermissionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Permissions", ctx)
+	ret0, _ := ret[0].([]entity.PermissionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Permissions indicates an expected call of Permissions.
+func (mr *MockRoleMockRecorder) Permissions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permissions", reflect.TypeOf((*MockRole)(nil).Permissions), ctx)
+}
+
+// Roles mocks base method.
+func (m *MockRole) Roles(ctx context.Context) ([]entity.RoleInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roles", ctx)
+	ret0, _ := ret[0].([]entity.RoleInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockRoleMockRecorder) Roles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockRole)(nil).Roles), ctx)
+}
+
+// UpdateRole mocks base method.
+func (m *MockRole) UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, name, description, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockRoleMockRecorder) UpdateRole(ctx, name, description, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRole)(nil).UpdateRole), ctx, name, description, permissions)
+}
+
+// MockRoleRepo is a mock of RoleRepo interface.
+type MockRoleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepoMockRecorder
+}
+
+// MockRoleRepoMockRecorder is the mock recorder for MockRoleRepo.
+type MockRoleRepoMockRecorder struct {
+	mock *MockRoleRepo
+}
+
+// NewMockRoleRepo creates a new mock instance.
+func NewMockRoleRepo(ctrl *gomock.Controller) *MockRoleRepo {
+	mock := &MockRoleRepo{ctrl: ctrl}
+	mock.recorder = &MockRoleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepo) EXPECT() *MockRoleRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteRole mocks base method.
+func (m *MockRoleRepo) DeleteRole(ctx context.Context, name entity.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockRoleRepoMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRoleRepo)(nil).DeleteRole), ctx, name)
+}
+
+// Permissions mocks base method.
+func (m *MockRoleRepo) Permissions(ctx context.Context) ([]entity.PermissionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Permissions", ctx)
+	ret0, _ := ret[0].([]entity.PermissionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Permissions indicates an expected call of Permissions.
+func (mr *MockRoleRepoMockRecorder) Permissions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permissions", reflect.TypeOf((*MockRoleRepo)(nil).Permissions), ctx)
+}
+
+// Roles mocks base method.
+func (m *MockRoleRepo) Roles(ctx context.Context) ([]entity.RoleInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roles", ctx)
+	ret0, _ := ret[0].([]entity.RoleInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockRoleRepoMockRecorder) Roles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockRoleRepo)(nil).Roles), ctx)
+}
+
+// SaveRole mocks base method.
+func (m *MockRoleRepo) SaveRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRole", ctx, name, description, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRole indicates an expected call of SaveRole.
+func (mr *MockRoleRepoMockRecorder) SaveRole(ctx, name, description, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRole", reflect.TypeOf((*MockRoleRepo)(nil).SaveRole), ctx, name, description, permissions)
+}
+
+// UpdateRole mocks base method.
+func (m *MockRoleRepo) UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, name, description, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockRoleRepoMockRecorder) UpdateRole(ctx, name, description, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRoleRepo)(nil).UpdateRole), ctx, name, description, permissions)
+}
+
+// MockProfile is a mock of Profile interface.
+type MockProfile struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileMockRecorder
+}
+
+// MockProfileMockRecorder is the mock recorder for MockProfile.
+type MockProfileMockRecorder struct {
+	mock *MockProfile
+}
+
+// NewMockProfile creates a new mock instance.
+func NewMockProfile(ctrl *gomock.Controller) *MockProfile {
+	mock := &MockProfile{ctrl: ctrl}
+	mock.recorder = &MockProfileMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfile) EXPECT() *MockProfileMockRecorder {
+	return m.recorder
+}
+
+// VkLaunchContexts mocks base method.
+func (m *MockProfile) VkLaunchContexts(ctx context.Context, userID, limit uint64) ([]entity.VkLaunchContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkLaunchContexts", ctx, userID, limit)
+	ret0, _ := ret[0].([]entity.VkLaunchContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkLaunchContexts indicates an expected call of VkLaunchContexts.
+func (mr *MockProfileMockRecorder) VkLaunchContexts(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkLaunchContexts", reflect.TypeOf((*MockProfile)(nil).VkLaunchContexts), ctx, userID, limit)
+}
+
+// VkProfile mocks base method.
+func (m *MockProfile) VkProfile(ctx context.Context, userID uint64) (entity.VkProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkProfile", ctx, userID)
+	ret0, _ := ret[0].(entity.VkProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkProfile indicates an expected call of VkProfile.
+func (mr *MockProfileMockRecorder) VkProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkProfile", reflect.TypeOf((*MockProfile)(nil).VkProfile), ctx, userID)
+}
+
+// MockProfileRepo is a mock of ProfileRepo interface.
+type MockProfileRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileRepoMockRecorder
+}
+
+// MockProfileRepoMockRecorder is the mock recorder for MockProfileRepo.
+type MockProfileRepoMockRecorder struct {
+	mock *MockProfileRepo
+}
+
+// NewMockProfileRepo creates a new mock instance.
+func NewMockProfileRepo(ctrl *gomock.Controller) *MockProfileRepo {
+	mock := &MockProfileRepo{ctrl: ctrl}
+	mock.recorder = &MockProfileRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileRepo) EXPECT() *MockProfileRepoMockRecorder {
+	return m.recorder
+}
+
+// VkLaunchContexts mocks base method.
+func (m *MockProfileRepo) VkLaunchContexts(ctx context.Context, userID, limit uint64) ([]entity.VkLaunchContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkLaunchContexts", ctx, userID, limit)
+	ret0, _ := ret[0].([]entity.VkLaunchContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkLaunchContexts indicates an expected call of VkLaunchContexts.
+func (mr *MockProfileRepoMockRecorder) VkLaunchContexts(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkLaunchContexts", reflect.TypeOf((*MockProfileRepo)(nil).VkLaunchContexts), ctx, userID, limit)
+}
+
+// VkProfile mocks base method.
+func (m *MockProfileRepo) VkProfile(ctx context.Context, userID uint64) (entity.VkProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkProfile", ctx, userID)
+	ret0, _ := ret[0].(entity.VkProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkProfile indicates an expected call of VkProfile.
+func (mr *MockProfileRepoMockRecorder) VkProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkProfile", reflect.TypeOf((*MockProfileRepo)(nil).VkProfile), ctx, userID)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/jwt"
+)
+
+// OAuthAuthorize - make upstream consent URL and signed state which must be returned back on callback.
+func (u *UserUseCase) OAuthAuthorize(provider string) (string, string, error) {
+	p, ok := u.oauthProvider(provider)
+	if !ok {
+		return "", "", entity.ErrOAuthProviderNotFound
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return "", "", fmt.Errorf("can't generate state: %w", err)
+	}
+
+	codeVerifier, err := randomString()
+	if err != nil {
+		return "", "", fmt.Errorf("can't generate code verifier: %w", err)
+	}
+
+	payload := map[string]any{
+		"provider": provider,
+		"state":    state,
+		"verifier": codeVerifier,
+	}
+
+	stateToken, err := jwt.NewToken(payload, u.oauthStateSecret(), u.oauthStateTTL)
+	if err != nil {
+		return "", "", fmt.Errorf("can't sign state: %w", err)
+	}
+
+	return p.AuthCodeURL(state, codeVerifier), stateToken, nil
+}
+
+// OAuthLogin - validate callback against signed state, exchange code and login by upstream identity.
+func (u *UserUseCase) OAuthLogin(ctx context.Context, provider, stateToken string, params url.Values) (*entity.User, string, error) {
+	p, ok := u.oauthProvider(provider)
+	if !ok {
+		return nil, "", entity.ErrOAuthProviderNotFound
+	}
+
+	claims, err := jwt.ParseToken(stateToken, u.oauthStateSecret())
+	if err != nil {
+		return nil, "", entity.ErrBadOAuthState
+	}
+
+	state, _ := claims["state"].(string)
+	codeVerifier, _ := claims["verifier"].(string)
+
+	if claims["provider"] != provider || state == "" ||
+		subtle.ConstantTimeCompare([]byte(state), []byte(params.Get("state"))) != 1 {
+		return nil, "", entity.ErrBadOAuthState
+	}
+
+	code := params.Get("code")
+	if code == "" {
+		return nil, "", entity.ErrBadOAuthCode
+	}
+
+	identity, err := p.Exchange(ctx, code, codeVerifier, params)
+	if errors.Is(err, entity.ErrBadOAuthCode) {
+		return nil, "", err
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed exchange oauth code: %w", err)
+	}
+
+	return u.doSocialLogin(ctx, identity.Provider, identity.ProviderID)
+}
+
+// oauthProvider - find provider by config name or by its stable identifier, e.g. "vk" for any vkid provider.
+func (u *UserUseCase) oauthProvider(name string) (OAuthProvider, bool) {
+	if p, ok := u.oauthProviders[name]; ok {
+		return p, true
+	}
+
+	for _, p := range u.oauthProviders {
+		if p.ID() == name {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// oauthStateSecret - state is signed by derived key, so it never passes as an access token.
+func (u *UserUseCase) oauthStateSecret() string {
+	return u.tokenSecret + ":oauth-state"
+}
+
+func randomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/VmesteApp/auth-service/pkg/cache"
+	"github.com/VmesteApp/auth-service/pkg/logger"
+)
+
+// Option -.
+type Option func(*UserUseCase)
+
+// TelegramBot -.
+func TelegramBot(token string, authTTL time.Duration) Option {
+	return func(u *UserUseCase) {
+		u.telegramBotToken = token
+		u.telegramAuthTTL = authTTL
+	}
+}
+
+// OAuthProviders -.
+func OAuthProviders(providers map[string]OAuthProvider, stateTTL time.Duration) Option {
+	return func(u *UserUseCase) {
+		u.oauthProviders = providers
+		u.oauthStateTTL = stateTTL
+	}
+}
+
+// VkLaunchParams - vk_app_id must match appID and vk_ts must be younger than maxAge,
+// signatures are remembered in replay cache when replayCacheSize > 0.
+func VkLaunchParams(appID int, maxAge time.Duration, replayCacheSize int) Option {
+	return func(u *UserUseCase) {
+		u.vkAppID = appID
+		u.launchParamsTTL = maxAge
+
+		if replayCacheSize > 0 {
+			u.launchParamsReplay = cache.New[string, struct{}](replayCacheSize, nil)
+		}
+	}
+}
+
+// VkProfileRefresh - VK profile is fetched on login when stored one is older than interval.
+func VkProfileRefresh(interval time.Duration) Option {
+	return func(u *UserUseCase) {
+		u.vkProfileRefresh = interval
+	}
+}
+
+// AnonymousAudit - failed logins of unknown users are audited once per interval per IP,
+// at most cacheSize IPs are remembered, 0 cacheSize audits all of them.
+func AnonymousAudit(interval time.Duration, cacheSize int) Option {
+	return func(u *UserUseCase) {
+		u.anonymousAuditInterval = interval
+
+		if cacheSize > 0 {
+			u.anonymousAudits = cache.New[string, struct{}](cacheSize, nil)
+		}
+	}
+}
+
+// Logger - log problems which don't break the login.
+func Logger(l logger.Interface) Option {
+	return func(u *UserUseCase) {
+		u.l = l
+	}
+}
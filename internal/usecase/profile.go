@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
 )
@@ -19,3 +20,16 @@ func (u *ProfileUseCase) VkProfile(ctx context.Context, userID uint64) (entity.V
 
 	return profile, err
 }
+
+func (u *ProfileUseCase) VkLaunchContexts(ctx context.Context, userID uint64, limit uint64) ([]entity.VkLaunchContext, error) {
+	if _, err := u.repo.VkProfile(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	launchContexts, err := u.repo.VkLaunchContexts(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("can't get vk launch contexts: %w", err)
+	}
+
+	return launchContexts, nil
+}
@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+// _auditLockKey - advisory lock serializing appends, so every event is chained to the previous one.
+const _auditLockKey = 7_243_001
+
+const _auditColumns = `id, actor_id, target_id, action, ip, user_agent, outcome, metadata, created_at, prev_hash, hash`
+
+// SaveAuditEvent - appends event to hash chain, ID, CreatedAt and hashes are set here.
+func (u *UserRepository) SaveAuditEvent(ctx context.Context, event entity.AuditEvent) error {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("can't start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	if err := u.saveAuditEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("can't commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// saveAuditEvent - appends event within tx, so it's saved only together with audited change.
+// Chain lock is held till the end of tx.
+func (u *UserRepository) saveAuditEvent(ctx context.Context, tx pgx.Tx, event entity.AuditEvent) error {
+	metadata, err := normalizeMetadata(event.Metadata)
+	if err != nil {
+		return err
+	}
+	event.Metadata = metadata
+
+	rawMetadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return fmt.Errorf("can't marshal audit metadata: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, _auditLockKey); err != nil {
+		return fmt.Errorf("can't lock audit log: %w", err)
+	}
+
+	var prevHash sql.NullString
+
+	err = tx.QueryRow(ctx, `SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1`).Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("can't get last audit event: %w", err)
+	}
+
+	event.PrevHash = prevHash.String
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	event.Hash = event.ComputeHash(u.auditKey)
+
+	query := `
+	INSERT INTO audit_events 
+		(actor_id, target_id, action, ip, user_agent, outcome, metadata, created_at, prev_hash, hash) 
+		VALUES (NULLIF($1::BIGINT, 0), NULLIF($2::BIGINT, 0), $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8, NULLIF($9, ''), $10)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		int64(event.ActorID), int64(event.TargetID), event.Action, event.IP, event.UserAgent,
+		event.Outcome, rawMetadata, event.CreatedAt, event.PrevHash, event.Hash,
+	)
+	if err != nil {
+		return fmt.Errorf("can't save audit event: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeMetadata - metadata as it is read back from JSONB, so its hash doesn't change after saving.
+func normalizeMetadata(metadata map[string]any) (map[string]any, error) {
+	normalized := map[string]any{}
+	if metadata == nil {
+		return normalized, nil
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal audit metadata: %w", err)
+	}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, fmt.Errorf("can't unmarshal audit metadata: %w", err)
+	}
+
+	return normalized, nil
+}
+
+// AuditEvents - at most filter.Limit events matching filter, ordered by id.
+func (u *UserRepository) AuditEvents(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	query := u.Builder.Select(_auditColumns).From("audit_events")
+
+	if filter.ActorID != 0 {
+		query = query.Where(squirrel.Eq{"actor_id": filter.ActorID})
+	}
+	if filter.TargetID != 0 {
+		query = query.Where(squirrel.Eq{"target_id": filter.TargetID})
+	}
+	if filter.Action != "" {
+		query = query.Where(squirrel.Eq{"action": filter.Action})
+	}
+	if filter.Outcome != "" {
+		query = query.Where(squirrel.Eq{"outcome": filter.Outcome})
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(squirrel.GtOrEq{"created_at": filter.From.UTC()})
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(squirrel.Lt{"created_at": filter.To.UTC()})
+	}
+
+	if filter.Desc {
+		if filter.AfterID != 0 {
+			query = query.Where(squirrel.Lt{"id": filter.AfterID})
+		}
+		query = query.OrderBy("id DESC")
+	} else {
+		if filter.AfterID != 0 {
+			query = query.Where(squirrel.Gt{"id": filter.AfterID})
+		}
+		query = query.OrderBy("id ASC")
+	}
+
+	sql, args, err := query.Limit(filter.Limit).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("can't build audit events query: %w", err)
+	}
+
+	rows, err := u.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can't find audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.AuditEvent, 0, filter.Limit)
+
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func scanAuditEvent(rows pgx.Rows) (entity.AuditEvent, error) {
+	var event entity.AuditEvent
+	var actorID, targetID sql.NullInt64
+	var ip, userAgent, prevHash, hash sql.NullString
+
+	err := rows.Scan(
+		&event.ID, &actorID, &targetID, &event.Action, &ip, &userAgent,
+		&event.Outcome, &event.Metadata, &event.CreatedAt, &prevHash, &hash,
+	)
+	if err != nil {
+		return entity.AuditEvent{}, fmt.Errorf("can't scan audit event: %w", err)
+	}
+
+	event.ActorID = uint64(actorID.Int64)
+	event.TargetID = uint64(targetID.Int64)
+	event.IP = ip.String
+	event.UserAgent = userAgent.String
+	event.PrevHash = prevHash.String
+	event.Hash = hash.String
+
+	return event, nil
+}
@@ -0,0 +1,214 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+const (
+	_uniqueViolation     = "23505"
+	_foreignKeyViolation = "23503"
+)
+
+const _roleColumns = `
+    r.name, r.description, r.builtin, r.version, r.created_at,
+    ARRAY(SELECT permission FROM role_permissions WHERE role = r.name ORDER BY permission) AS permissions
+`
+
+func (u *UserRepository) Roles(ctx context.Context) ([]entity.RoleInfo, error) {
+	sql := `SELECT ` + _roleColumns + ` FROM roles r ORDER BY r.builtin DESC, r.name`
+
+	rows, err := u.Pool.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("can't find roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]entity.RoleInfo, 0)
+
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (u *UserRepository) Role(ctx context.Context, name entity.Role) (entity.RoleInfo, error) {
+	sql := `SELECT ` + _roleColumns + ` FROM roles r WHERE r.name = $1`
+
+	rows, err := u.Pool.Query(ctx, sql, name)
+	if err != nil {
+		return entity.RoleInfo{}, fmt.Errorf("can't find role: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		return scanRole(rows)
+	}
+	if err := rows.Err(); err != nil {
+		return entity.RoleInfo{}, fmt.Errorf("can't find role: %w", err)
+	}
+
+	return entity.RoleInfo{}, entity.ErrRoleNotFound
+}
+
+func scanRole(rows pgx.Rows) (entity.RoleInfo, error) {
+	var role entity.RoleInfo
+	var permissions []string
+
+	err := rows.Scan(&role.Name, &role.Description, &role.Builtin, &role.Version, &role.CreatedAt, &permissions)
+	if err != nil {
+		return entity.RoleInfo{}, fmt.Errorf("can't scan role: %w", err)
+	}
+
+	role.Permissions = make([]entity.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, entity.Permission(permission))
+	}
+
+	return role, nil
+}
+
+func (u *UserRepository) Permissions(ctx context.Context) ([]entity.PermissionInfo, error) {
+	rows, err := u.Pool.Query(ctx, `SELECT name, description FROM permissions ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("can't find permissions: %w", err)
+	}
+	defer rows.Close()
+
+	permissions := make([]entity.PermissionInfo, 0)
+
+	for rows.Next() {
+		var permission entity.PermissionInfo
+
+		if err := rows.Scan(&permission.Name, &permission.Description); err != nil {
+			return nil, fmt.Errorf("can't scan permission: %w", err)
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+func (u *UserRepository) SaveRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	_, err = tx.Exec(ctx, `INSERT INTO roles (name, description) VALUES ($1, $2)`, name, description)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+			return entity.ErrRoleExists
+		}
+
+		return fmt.Errorf("can't save role: %w", err)
+	}
+
+	if err := savePermissions(ctx, tx, name, permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateRole - replace description and permissions of custom role, role version is incremented.
+func (u *UserRepository) UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	var builtin bool
+
+	err = tx.QueryRow(ctx, `
+		UPDATE roles SET description = $2, version = version + 1 WHERE name = $1 RETURNING builtin
+	`, name, description).Scan(&builtin)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrRoleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("can't update role: %w", err)
+	}
+	if builtin {
+		return entity.ErrRoleBuiltin
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM role_permissions WHERE role = $1`, name)
+	if err != nil {
+		return fmt.Errorf("can't delete role permissions: %w", err)
+	}
+
+	if err := savePermissions(ctx, tx, name, permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func savePermissions(ctx context.Context, tx pgx.Tx, role entity.Role, permissions []entity.Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		names = append(names, string(permission))
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO role_permissions (role, permission) SELECT $1, unnest($2::VARCHAR[]) ON CONFLICT DO NOTHING
+	`, role, names)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+			return entity.ErrPermissionNotFound
+		}
+
+		return fmt.Errorf("can't save role permissions: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserRepository) DeleteRole(ctx context.Context, name entity.Role) error {
+	var builtin bool
+
+	err := u.Pool.QueryRow(ctx, `SELECT builtin FROM roles WHERE name = $1`, name).Scan(&builtin)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrRoleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("can't find role: %w", err)
+	}
+	if builtin {
+		return entity.ErrRoleBuiltin
+	}
+
+	_, err = u.Pool.Exec(ctx, `DELETE FROM roles WHERE name = $1`, name)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+			return entity.ErrRoleInUse
+		}
+
+		return fmt.Errorf("can't delete role: %w", err)
+	}
+
+	return nil
+}
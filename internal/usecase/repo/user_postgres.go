@@ -3,66 +3,202 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/VmesteApp/auth-service/internal/entity"
 	"github.com/VmesteApp/auth-service/pkg/postgres"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
 type UserRepository struct {
 	*postgres.Postgres
+	auditKey []byte
 }
 
-func NewUserRepository(pg *postgres.Postgres) *UserRepository {
-	return &UserRepository{pg}
+// NewUserRepository - auditKey is HMAC key of audit log hash chain.
+func NewUserRepository(pg *postgres.Postgres, auditKey []byte) *UserRepository {
+	return &UserRepository{Postgres: pg, auditKey: auditKey}
 }
 
 func (u *UserRepository) SaveUser(ctx context.Context, email string, passHash []byte) error {
-	return u.doSaveUser(ctx, email, passHash, entity.UserRole)
+	_, err := doSaveUser(ctx, u.Pool, email, passHash, entity.UserRole)
+
+	return err
 }
 
-// TODO: are there need join of SocialLogin?
+// _userColumns - users columns with aggregated social logins, scanned by scanUser.
+const _userColumns = `
+    u.id, u.email, u.pass_hash, u.role, u.status, u.status_until, u.status_reason, u.token_version, u.created_at,
+    ARRAY(SELECT id FROM social_logins WHERE user_id = u.id ORDER BY id) AS social_login_ids,
+    ARRAY(SELECT provider FROM social_logins WHERE user_id = u.id ORDER BY id) AS providers,
+    ARRAY(SELECT provider_id FROM social_logins WHERE user_id = u.id ORDER BY id) AS provider_ids
+`
+
 func (u *UserRepository) User(ctx context.Context, email string) (*entity.User, error) {
-	sql := `
-	SELECT 
-    u.id, u.email, u.pass_hash, u.role,
-    ARRAY(SELECT id FROM social_logins WHERE user_id = u.id) AS social_login_ids,
-    ARRAY(SELECT provider FROM social_logins WHERE user_id = u.id) AS providers,
-    ARRAY(SELECT provider_id FROM social_logins WHERE user_id = u.id) AS provider_ids
-		FROM users u
-		WHERE u.email = $1
-		GROUP BY u.id, u.email, u.pass_hash, u.role;
-	`
+	sql := `SELECT ` + _userColumns + ` FROM users u WHERE u.email = $1 AND u.deleted_at IS NULL`
+
+	return u.doFindUser(ctx, sql, email)
+}
+
+func (u *UserRepository) UserByID(ctx context.Context, userID uint64) (*entity.User, error) {
+	sql := `SELECT ` + _userColumns + ` FROM users u WHERE u.id = $1 AND u.deleted_at IS NULL`
+
+	return u.doFindUser(ctx, sql, userID)
+}
 
-	rows, err := u.Pool.Query(ctx, sql, email)
+// Users - at most filter.Limit users matching filter, ordered by filter sort with id as tie-breaker.
+func (u *UserRepository) Users(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	sql, args, err := usersQuery(u.Builder, filter).ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("can't to find user by email: %w", err)
+		return nil, fmt.Errorf("can't build users query: %w", err)
+	}
+
+	rows, err := u.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can't find users: %w", err)
 	}
 	defer rows.Close()
 
-	var user entity.User
-	if rows.Next() {
-		var ids []*uint64
-		var providers, providerIds []*string
+	users := make([]*entity.User, 0, filter.Limit)
 
-		err := rows.Scan(&user.ID, &user.Email, &user.PassHash, &user.Role, &ids, &providers, &providerIds)
+	for rows.Next() {
+		user, err := scanUser(rows)
 		if err != nil {
-			return nil, fmt.Errorf("can't to scan user: %w", err)
+			return nil, err
 		}
 
-		for i := 0; i < len(ids); i++ {
-			el := entity.SocialLogin{ID: *ids[0], UserID: user.ID, ProviderID: *providerIds[i], Provider: *providers[i]}
-			user.SocialLogins = append(user.SocialLogins, &el)
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+func usersQuery(builder squirrel.StatementBuilderType, filter entity.UserFilter) squirrel.SelectBuilder {
+	query := builder.Select(_userColumns).From("users u").Where("u.deleted_at IS NULL")
+
+	if filter.Role != "" {
+		query = query.Where(squirrel.Eq{"u.role": filter.Role})
+	}
+	if filter.Provider != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM social_logins s WHERE s.user_id = u.id AND s.provider = ?)", filter.Provider)
+	}
+	if filter.VkID != 0 {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM social_logins s WHERE s.user_id = u.id AND s.provider = ? AND s.provider_id = ?)",
+			entity.VkProvider, strconv.Itoa(filter.VkID),
+		)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where(squirrel.GtOrEq{"u.created_at": filter.CreatedFrom})
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where(squirrel.Lt{"u.created_at": filter.CreatedTo})
+	}
+	if filter.EmailPrefix != "" {
+		query = query.Where(squirrel.Like{"u.email": escapeLike(filter.EmailPrefix) + "%"})
+	}
+
+	op, order := ">", "ASC"
+	if filter.Desc {
+		op, order = "<", "DESC"
+	}
+
+	switch filter.Sort {
+	case entity.SortByCreatedAt:
+		if filter.After != nil {
+			query = query.Where("(u.created_at, u.id) "+op+" (?, ?)", filter.After.CreatedAt, filter.After.ID)
 		}
+		query = query.OrderBy("u.created_at "+order, "u.id "+order)
+	default:
+		if filter.After != nil {
+			query = query.Where("u.id "+op+" ?", filter.After.ID)
+		}
+		query = query.OrderBy("u.id " + order)
+	}
 
-		return &user, nil
+	return query.Limit(filter.Limit)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func (u *UserRepository) SocialLogins(ctx context.Context, userID uint64) ([]*entity.SocialLogin, error) {
+	sql := `SELECT id, user_id, provider, provider_id FROM social_logins WHERE user_id = $1 ORDER BY id`
+
+	rows, err := u.Pool.Query(ctx, sql, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't find social logins: %w", err)
+	}
+	defer rows.Close()
+
+	socialLogins := make([]*entity.SocialLogin, 0)
+
+	for rows.Next() {
+		var socialLogin entity.SocialLogin
+
+		err := rows.Scan(&socialLogin.ID, &socialLogin.UserID, &socialLogin.Provider, &socialLogin.ProviderID)
+		if err != nil {
+			return nil, fmt.Errorf("can't scan social login: %w", err)
+		}
+
+		socialLogins = append(socialLogins, &socialLogin)
+	}
+
+	return socialLogins, rows.Err()
+}
+
+func (u *UserRepository) doFindUser(ctx context.Context, sql string, args ...any) (*entity.User, error) {
+	rows, err := u.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can't to find user: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		return scanUser(rows)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("can't to find user: %w", err)
 	}
 
 	return nil, entity.ErrUserNotFound
 }
 
+func scanUser(rows pgx.Rows) (*entity.User, error) {
+	var user entity.User
+	var email, statusReason sql.NullString
+	var ids []*uint64
+	var providers, providerIds []*string
+
+	err := rows.Scan(
+		&user.ID, &email, &user.PassHash, &user.Role,
+		&user.Status, &user.StatusUntil, &statusReason, &user.TokenVersion, &user.CreatedAt,
+		&ids, &providers, &providerIds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't to scan user: %w", err)
+	}
+
+	user.Email = email.String
+	user.StatusReason = statusReason.String
+
+	for i := 0; i < len(ids); i++ {
+		el := entity.SocialLogin{ID: *ids[i], UserID: user.ID, ProviderID: *providerIds[i], Provider: *providers[i]}
+		user.SocialLogins = append(user.SocialLogins, &el)
+	}
+
+	return &user, nil
+}
+
 func (u *UserRepository) SaveSocialUser(ctx context.Context, provider, providerID string) (*entity.User, error) {
 	tx, err := u.Postgres.Pool.Begin(ctx)
 	if err != nil {
@@ -90,19 +226,28 @@ func (u *UserRepository) SaveSocialUser(ctx context.Context, provider, providerI
 
 	_, err = tx.Exec(ctx, sql, newUserId, provider, providerID)
 	if err != nil {
+		// the same social login is saved concurrently
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+			return nil, entity.ErrUserExists
+		}
+
 		return nil, fmt.Errorf("failed to insert social_logins: %w", err)
 	}
 
 	return &entity.User{
-		ID:   newUserId,
-		Role: entity.UserRole,
+		ID:     newUserId,
+		Role:   entity.UserRole,
+		Status: entity.ActiveStatus,
 	}, nil
 }
 
+// SocialUser - ErrUserDeleted if social login belongs to soft deleted user, so it isn't registered again.
 func (u *UserRepository) SocialUser(ctx context.Context, provider, providerId string) (*entity.User, error) {
 	query := `
 	SELECT 
-		u.id, u.email, u.pass_hash, u.role 
+		u.id, u.email, u.pass_hash, u.role, u.status, u.status_until, u.status_reason, u.token_version, u.created_at,
+		u.deleted_at IS NOT NULL
 		FROM users u 
 		JOIN social_logins s 
 			ON s.user_id = u.id 
@@ -118,13 +263,21 @@ func (u *UserRepository) SocialUser(ctx context.Context, provider, providerId st
 	var user entity.User
 
 	if rows.Next() {
-		var email sql.NullString
+		var email, statusReason sql.NullString
 		var passHash sql.Null[[]byte]
+		var deleted bool
 
-		err := rows.Scan(&user.ID, &email, &passHash, &user.Role)
+		err := rows.Scan(
+			&user.ID, &email, &passHash, &user.Role,
+			&user.Status, &user.StatusUntil, &statusReason, &user.TokenVersion, &user.CreatedAt,
+			&deleted,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("can't to scan user: %w", err)
 		}
+		if deleted {
+			return nil, entity.ErrUserDeleted
+		}
 
 		if email.Valid {
 			user.Email = email.String
@@ -132,6 +285,7 @@ func (u *UserRepository) SocialUser(ctx context.Context, provider, providerId st
 		if passHash.Valid {
 			user.PassHash = passHash.V
 		}
+		user.StatusReason = statusReason.String
 
 		return &user, nil
 	}
@@ -140,7 +294,7 @@ func (u *UserRepository) SocialUser(ctx context.Context, provider, providerId st
 }
 
 func (u *UserRepository) Admins(ctx context.Context) ([]entity.Admin, error) {
-	sql := `SELECT id, email FROM users WHERE role = $1`
+	sql := `SELECT id, email FROM users WHERE role = $1 AND deleted_at IS NULL`
 
 	rows, err := u.Pool.Query(ctx, sql, entity.AdminRole)
 	if err != nil {
@@ -164,42 +318,302 @@ func (u *UserRepository) Admins(ctx context.Context) ([]entity.Admin, error) {
 	return admins, nil
 }
 
-func (u *UserRepository) DeleteAdmin(ctx context.Context, userID uint64) error {
-	sql := `DELETE FROM users WHERE id = $1`
+// DeleteAdmin - soft deletes admin and revokes issued tokens, ErrUserNotFound if there is no such admin.
+// Audit event is saved in the same transaction.
+func (u *UserRepository) DeleteAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error {
+	sql := `
+	UPDATE users SET deleted_at = NOW(), token_version = token_version + 1
+		WHERE id = $1 AND role = $2 AND deleted_at IS NULL
+	`
+
+	return u.doAuditedUpdate(ctx, event, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, sql, userID, entity.AdminRole)
+	})
+}
+
+// RestoreAdmin - audit event is saved in the same transaction.
+func (u *UserRepository) RestoreAdmin(ctx context.Context, userID uint64, event entity.AuditEvent) error {
+	sql := `UPDATE users SET deleted_at = NULL WHERE id = $1 AND role = $2 AND deleted_at IS NOT NULL`
+
+	return u.doAuditedUpdate(ctx, event, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, sql, userID, entity.AdminRole)
+	})
+}
+
+// SetUserPassword - revokes issued tokens, audit event is saved in the same transaction.
+func (u *UserRepository) SetUserPassword(ctx context.Context, userID uint64, passHash []byte, event entity.AuditEvent) error {
+	sql := `
+	UPDATE users SET pass_hash = $2, token_version = token_version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	return u.doAuditedUpdate(ctx, event, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, sql, userID, passHash)
+	})
+}
+
+// SetUserRole - changes role and revokes issued tokens, returns previous role.
+// Superadmin rows are locked, so concurrent demotions can't remove the last superadmin.
+// Audit event is saved in the same transaction with previous role added to its metadata as "from".
+func (u *UserRepository) SetUserRole(ctx context.Context, userID uint64, role entity.Role, event entity.AuditEvent) (entity.Role, error) {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("can't start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	var superAdmins []uint64
+
+	rows, err := tx.Query(ctx, `SELECT id FROM users WHERE role = $1 AND deleted_at IS NULL ORDER BY id FOR UPDATE`, entity.SuperAdminRole)
+	if err != nil {
+		return "", fmt.Errorf("can't lock superadmins: %w", err)
+	}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+
+			return "", fmt.Errorf("can't scan superadmin: %w", err)
+		}
+		superAdmins = append(superAdmins, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("can't lock superadmins: %w", err)
+	}
+
+	var previous entity.Role
+
+	err = tx.QueryRow(ctx, `SELECT role FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, userID).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("can't get user role: %w", err)
+	}
+
+	if previous == entity.SuperAdminRole && role != entity.SuperAdminRole && len(superAdmins) <= 1 {
+		return "", entity.ErrLastSuperAdmin
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET role = $2, token_version = token_version + 1 WHERE id = $1`, userID, role)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+		return "", entity.ErrBadRole
+	}
+	if err != nil {
+		return "", fmt.Errorf("can't update user role: %w", err)
+	}
+
+	event.Metadata = withMetadata(event.Metadata, "from", previous)
+	if err := u.saveAuditEvent(ctx, tx, event); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("can't commit transaction: %w", err)
+	}
+
+	return previous, nil
+}
+
+// roleRankSQL - SQL expression ranking role of users table alias: user < custom roles < admin, service < superadmin.
+// Ranks come from entity.Role.Rank, so usecase and SQL checks agree.
+func roleRankSQL(alias string) string {
+	var b strings.Builder
+
+	b.WriteString("CASE " + alias + ".role")
+	for _, role := range []entity.Role{entity.UserRole, entity.AdminRole, entity.ServiceRole, entity.SuperAdminRole} {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", role, role.Rank())
+	}
+	// any custom role
+	fmt.Fprintf(&b, " ELSE %d END", entity.Role("").Rank())
+
+	return b.String()
+}
+
+// SetUserStatus - until and reason are cleared when they are empty.
+// Actor may moderate only users with lower role, actorID 0 is operator tool, and superadmin is never blocked.
+// The check is a part of UPDATE, so it holds against concurrent role change, ErrProtectedUser otherwise.
+// Audit event is saved in the same transaction with previous status added to its metadata as "from".
+func (u *UserRepository) SetUserStatus(
+	ctx context.Context,
+	actorID, userID uint64,
+	status entity.Status,
+	until *time.Time,
+	reason string,
+	event entity.AuditEvent,
+) error {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("can't start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	var previous entity.Status
+
+	err = tx.QueryRow(ctx, `SELECT status FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, userID).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("can't get user status: %w", err)
+	}
 
-	_, err := u.Pool.Exec(ctx, sql, userID)
+	sql := `
+	UPDATE users u SET status = $2, status_until = $3, status_reason = NULLIF($4, '')
+		WHERE u.id = $1 AND u.deleted_at IS NULL
+		AND NOT (u.role = 'superadmin' AND $2 <> 'active')
+		AND ($5::BIGINT = 0 OR ` + roleRankSQL("u") + ` < (
+			SELECT ` + roleRankSQL("a") + ` FROM users a WHERE a.id = $5 AND a.deleted_at IS NULL
+		))
+	`
+
+	tag, err := tx.Exec(ctx, sql, userID, status, until, reason, int64(actorID))
 	if err != nil {
-		return fmt.Errorf("can't delete user: %w", err)
+		return fmt.Errorf("can't update user status: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrProtectedUser
+	}
+
+	event.Metadata = withMetadata(event.Metadata, "from", previous)
+	if err := u.saveAuditEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("can't commit transaction: %w", err)
 	}
 
 	return nil
 }
 
-func (u *UserRepository) SaveAdmin(ctx context.Context, email string, passHash []byte) error {
-	return u.doSaveUser(ctx, email, passHash, entity.AdminRole)
+// doAuditedUpdate - update and its audit event are committed together, ErrUserNotFound if no row is updated.
+func (u *UserRepository) doAuditedUpdate(
+	ctx context.Context,
+	event entity.AuditEvent,
+	update func(tx pgx.Tx) (pgconn.CommandTag, error),
+) error {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("can't start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	tag, err := update(tx)
+	if err != nil {
+		return fmt.Errorf("can't update user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrUserNotFound
+	}
+
+	if err := u.saveAuditEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("can't commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// withMetadata - copy of metadata with key set, so caller's map isn't changed.
+func withMetadata(metadata map[string]any, key string, value any) map[string]any {
+	result := make(map[string]any, len(metadata)+1)
+	for k, v := range metadata {
+		result[k] = v
+	}
+	result[key] = value
+
+	return result
+}
+
+func (u *UserRepository) doUpdateUser(ctx context.Context, sql string, userID uint64, value any) error {
+	tag, err := u.Pool.Exec(ctx, sql, userID, value)
+	if err != nil {
+		return fmt.Errorf("can't update user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// SaveAdmin - audit event targeting new admin is saved in the same transaction.
+func (u *UserRepository) SaveAdmin(ctx context.Context, email string, passHash []byte, event entity.AuditEvent) (uint64, error) {
+	tx, err := u.Pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("can't start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	userID, err := doSaveUser(ctx, tx, email, passHash, entity.AdminRole)
+	if err != nil {
+		return 0, err
+	}
+
+	event.TargetID = userID
+	if err := u.saveAuditEvent(ctx, tx, event); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("can't commit transaction: %w", err)
+	}
+
+	return userID, nil
+}
+
+// rowQuerier - pool or transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
-func (u *UserRepository) doSaveUser(ctx context.Context, email string, passHash []byte, role entity.Role) error {
-	sql := `INSERT INTO users (email, pass_hash, role) VALUES ($1, $2, $3)`
+func doSaveUser(ctx context.Context, q rowQuerier, email string, passHash []byte, role entity.Role) (uint64, error) {
+	sql := `INSERT INTO users (email, pass_hash, role) VALUES ($1, $2, $3) RETURNING id`
 
-	_, err := u.Pool.Exec(ctx, sql, email, passHash, role)
+	var userID uint64
+
+	err := q.QueryRow(ctx, sql, email, passHash, role).Scan(&userID)
 	if err != nil {
-		if code, _ := err.(*pgconn.PgError); code.Code == "23505" {
-			return entity.ErrUserExists
+		if code, _ := err.(*pgconn.PgError); code != nil && code.Code == "23505" {
+			return 0, entity.ErrUserExists
 		}
 
-		return fmt.Errorf("can't to save user: %w", err)
+		return 0, fmt.Errorf("can't to save user: %w", err)
 	}
 
-	return nil
+	return userID, nil
 }
 
+// VkProfile - ErrUserNotFound for deleted user too.
 func (u *UserRepository) VkProfile(ctx context.Context, userID uint64) (entity.VkProfile, error) {
-	sql := `SELECT provider_id FROM social_logins WHERE provider = 'vk' AND user_id = $1`
+	sql := `
+	SELECT
+		s.provider_id,
+		p.first_name, p.last_name, p.photo_url, p.screen_name, p.updated_at,
+		c.platform, c.language, c.is_app_user, c.are_notifications_enabled, c.is_favorite,
+		c.ref, c.group_id, c.viewer_group_role, c.chat_id, c.created_at
+		FROM social_logins s
+		JOIN users u ON u.id = s.user_id AND u.deleted_at IS NULL
+		LEFT JOIN vk_profiles p ON p.user_id = s.user_id
+		LEFT JOIN LATERAL (
+			SELECT * FROM vk_launch_contexts WHERE user_id = s.user_id ORDER BY id DESC LIMIT 1
+		) c ON TRUE
+		WHERE s.provider = $1 AND s.user_id = $2
+	`
 
 	var parsedVkID string
+	var info vkUserInfoRow
+	var launchContext vkLaunchContextRow
 
-	err := u.Pool.QueryRow(ctx, sql, userID).Scan(&parsedVkID)
+	dest := append([]any{&parsedVkID}, info.dest()...)
+	err := u.Pool.QueryRow(ctx, sql, entity.VkProvider, userID).Scan(append(dest, launchContext.dest()...)...)
 
 	if err != nil {
 		if err.Error() == "no rows in result set" {
@@ -215,7 +629,259 @@ func (u *UserRepository) VkProfile(ctx context.Context, userID uint64) (entity.V
 	}
 
 	return entity.VkProfile{
-		UserID: userID,
-		VkID:   vkID,
+		UserID:        userID,
+		VkID:          vkID,
+		Info:          info.entity(vkID),
+		LaunchContext: launchContext.entity(),
 	}, nil
 }
+
+// VkIDs - deleted users are skipped as absent.
+func (u *UserRepository) VkIDs(ctx context.Context, userIDs []uint64) (map[uint64]int, error) {
+	sql := `
+	SELECT s.user_id, s.provider_id
+		FROM social_logins s
+		JOIN users u ON u.id = s.user_id AND u.deleted_at IS NULL
+		WHERE s.provider = $1 AND s.user_id = ANY($2)
+	`
+
+	rows, err := u.Pool.Query(ctx, sql, entity.VkProvider, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("can't find vk ids: %w", err)
+	}
+	defer rows.Close()
+
+	vkIDs := make(map[uint64]int, len(userIDs))
+
+	for rows.Next() {
+		var userID uint64
+		var parsedVkID string
+
+		if err := rows.Scan(&userID, &parsedVkID); err != nil {
+			return nil, fmt.Errorf("can't scan vk id: %w", err)
+		}
+
+		vkID, err := strconv.Atoi(parsedVkID)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse provider id: %w", err)
+		}
+
+		vkIDs[userID] = vkID
+	}
+
+	return vkIDs, rows.Err()
+}
+
+// UserIDsByVkIDs - deleted users are skipped as absent.
+func (u *UserRepository) UserIDsByVkIDs(ctx context.Context, vkIDs []int) (map[int]uint64, error) {
+	sql := `
+	SELECT s.provider_id, s.user_id
+		FROM social_logins s
+		JOIN users u ON u.id = s.user_id AND u.deleted_at IS NULL
+		WHERE s.provider = $1 AND s.provider_id = ANY($2)
+	`
+
+	providerIDs := make([]string, 0, len(vkIDs))
+	for _, vkID := range vkIDs {
+		providerIDs = append(providerIDs, strconv.Itoa(vkID))
+	}
+
+	rows, err := u.Pool.Query(ctx, sql, entity.VkProvider, providerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("can't find users by vk ids: %w", err)
+	}
+	defer rows.Close()
+
+	userIDs := make(map[int]uint64, len(vkIDs))
+
+	for rows.Next() {
+		var parsedVkID string
+		var userID uint64
+
+		if err := rows.Scan(&parsedVkID, &userID); err != nil {
+			return nil, fmt.Errorf("can't scan user id: %w", err)
+		}
+
+		vkID, err := strconv.Atoi(parsedVkID)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse provider id: %w", err)
+		}
+
+		userIDs[vkID] = userID
+	}
+
+	return userIDs, rows.Err()
+}
+
+func (u *UserRepository) VkUserInfo(ctx context.Context, userID uint64) (*entity.VkUserInfo, error) {
+	sql := `
+	SELECT vk_id, first_name, last_name, photo_url, screen_name, updated_at
+		FROM vk_profiles
+		WHERE user_id = $1
+	`
+
+	rows, err := u.Pool.Query(ctx, sql, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't find vk profile: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	var vkID int
+	var info vkUserInfoRow
+
+	if err := rows.Scan(append([]any{&vkID}, info.dest()...)...); err != nil {
+		return nil, fmt.Errorf("can't scan vk profile: %w", err)
+	}
+
+	return info.entity(vkID), nil
+}
+
+// SaveVkUserInfo - TIMESTAMP column keeps wall clock, so time is saved in UTC.
+func (u *UserRepository) SaveVkUserInfo(ctx context.Context, userID uint64, info entity.VkUserInfo) error {
+	sql := `
+		INSERT INTO vk_profiles
+			(user_id, vk_id, first_name, last_name, photo_url, screen_name, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE SET
+			vk_id = EXCLUDED.vk_id,
+			first_name = EXCLUDED.first_name,
+			last_name = EXCLUDED.last_name,
+			photo_url = EXCLUDED.photo_url,
+			screen_name = EXCLUDED.screen_name,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := u.Pool.Exec(ctx, sql, userID, info.VkID, nullString(info.FirstName), nullString(info.LastName),
+		nullString(info.PhotoURL), nullString(info.ScreenName), info.UpdatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("can't save vk profile: %w", err)
+	}
+
+	return nil
+}
+
+// SaveVkLaunchContext - TIMESTAMP column keeps wall clock, so time is saved in UTC.
+func (u *UserRepository) SaveVkLaunchContext(ctx context.Context, userID uint64, c entity.VkLaunchContext) error {
+	sql := `
+		INSERT INTO vk_launch_contexts
+			(user_id, platform, language, is_app_user, are_notifications_enabled, is_favorite,
+			ref, group_id, viewer_group_role, chat_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := u.Pool.Exec(ctx, sql, userID, nullString(c.Platform), nullString(c.Language), c.IsAppUser,
+		c.AreNotificationsEnabled, c.IsFavorite, nullString(c.Ref), nullInt64(c.GroupID),
+		nullString(c.ViewerGroupRole), nullString(c.ChatID), c.LaunchedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("can't save vk launch context: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserRepository) VkLaunchContexts(ctx context.Context, userID uint64, limit uint64) ([]entity.VkLaunchContext, error) {
+	sql := `
+	SELECT
+		platform, language, is_app_user, are_notifications_enabled, is_favorite,
+		ref, group_id, viewer_group_role, chat_id, created_at
+		FROM vk_launch_contexts
+		WHERE user_id = $1
+		ORDER BY id DESC
+		LIMIT $2
+	`
+
+	rows, err := u.Pool.Query(ctx, sql, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("can't find vk launch contexts: %w", err)
+	}
+	defer rows.Close()
+
+	launchContexts := make([]entity.VkLaunchContext, 0)
+
+	for rows.Next() {
+		var row vkLaunchContextRow
+
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("can't scan vk launch context: %w", err)
+		}
+
+		launchContexts = append(launchContexts, *row.entity())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("can't read vk launch contexts: %w", err)
+	}
+
+	return launchContexts, nil
+}
+
+// vkLaunchContextRow - nullable columns of vk_launch_contexts, all NULL when user has no launches yet.
+type vkLaunchContextRow struct {
+	platform, language, ref, viewerGroupRole, chatID sql.NullString
+	isAppUser, areNotificationsEnabled, isFavorite   sql.NullBool
+	groupID                                          sql.NullInt64
+	createdAt                                        sql.NullTime
+}
+
+func (r *vkLaunchContextRow) dest() []any {
+	return []any{
+		&r.platform, &r.language, &r.isAppUser, &r.areNotificationsEnabled, &r.isFavorite,
+		&r.ref, &r.groupID, &r.viewerGroupRole, &r.chatID, &r.createdAt,
+	}
+}
+
+func (r *vkLaunchContextRow) entity() *entity.VkLaunchContext {
+	if !r.createdAt.Valid {
+		return nil
+	}
+
+	return &entity.VkLaunchContext{
+		Platform:                r.platform.String,
+		Language:                r.language.String,
+		IsAppUser:               r.isAppUser.Bool,
+		AreNotificationsEnabled: r.areNotificationsEnabled.Bool,
+		IsFavorite:              r.isFavorite.Bool,
+		Ref:                     r.ref.String,
+		GroupID:                 r.groupID.Int64,
+		ViewerGroupRole:         r.viewerGroupRole.String,
+		ChatID:                  r.chatID.String,
+		LaunchedAt:              r.createdAt.Time,
+	}
+}
+
+// vkUserInfoRow - nullable columns of vk_profiles, all NULL when profile was not fetched yet.
+type vkUserInfoRow struct {
+	firstName, lastName, photoURL, screenName sql.NullString
+	updatedAt                                 sql.NullTime
+}
+
+func (r *vkUserInfoRow) dest() []any {
+	return []any{&r.firstName, &r.lastName, &r.photoURL, &r.screenName, &r.updatedAt}
+}
+
+func (r *vkUserInfoRow) entity(vkID int) *entity.VkUserInfo {
+	if !r.updatedAt.Valid {
+		return nil
+	}
+
+	return &entity.VkUserInfo{
+		VkID:       vkID,
+		FirstName:  r.firstName.String,
+		LastName:   r.lastName.String,
+		PhotoURL:   r.photoURL.String,
+		ScreenName: r.screenName.String,
+		UpdatedAt:  r.updatedAt.Time,
+	}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullInt64(i int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: i, Valid: i != 0}
+}
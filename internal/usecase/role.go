@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+var rolePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,19}$`)
+
+type RoleUseCase struct {
+	repo RoleRepo
+}
+
+func NewRoleUseCase(repo RoleRepo) *RoleUseCase {
+	return &RoleUseCase{repo: repo}
+}
+
+func (u *RoleUseCase) Roles(ctx context.Context) ([]entity.RoleInfo, error) {
+	roles, err := u.repo.Roles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+func (u *RoleUseCase) Permissions(ctx context.Context) ([]entity.PermissionInfo, error) {
+	permissions, err := u.repo.Permissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get permissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+func (u *RoleUseCase) CreateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	if !rolePattern.MatchString(string(name)) {
+		return entity.ErrBadRole
+	}
+
+	err := u.repo.SaveRole(ctx, name, description, permissions)
+	if errors.Is(err, entity.ErrRoleExists) || errors.Is(err, entity.ErrPermissionNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("can't save role: %w", err)
+	}
+
+	return nil
+}
+
+func (u *RoleUseCase) UpdateRole(ctx context.Context, name entity.Role, description string, permissions []entity.Permission) error {
+	err := u.repo.UpdateRole(ctx, name, description, permissions)
+	if errors.Is(err, entity.ErrRoleNotFound) || errors.Is(err, entity.ErrRoleBuiltin) || errors.Is(err, entity.ErrPermissionNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("can't update role: %w", err)
+	}
+
+	return nil
+}
+
+func (u *RoleUseCase) DeleteRole(ctx context.Context, name entity.Role) error {
+	err := u.repo.DeleteRole(ctx, name)
+	if errors.Is(err, entity.ErrRoleNotFound) || errors.Is(err, entity.ErrRoleBuiltin) || errors.Is(err, entity.ErrRoleInUse) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("can't delete role: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
@@ -14,27 +15,54 @@ import (
 	"time"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/cache"
 	"github.com/VmesteApp/auth-service/pkg/jwt"
+	"github.com/VmesteApp/auth-service/pkg/logger"
+	"github.com/VmesteApp/auth-service/pkg/reqmeta"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const _profileRefreshTimeout = 5 * time.Second
+
 type UserUseCase struct {
 	repo        UserRepo
 	api         VkWebApi
 	tokenSecret string
 	tokenTTL    time.Duration
 	privateKey  string
+
+	vkAppID            int
+	launchParamsTTL    time.Duration
+	launchParamsReplay *cache.Cache[string, struct{}]
+	vkProfileRefresh   time.Duration
+
+	telegramBotToken string
+	telegramAuthTTL  time.Duration
+
+	oauthProviders map[string]OAuthProvider
+	oauthStateTTL  time.Duration
+
+	anonymousAudits        *cache.Cache[string, struct{}]
+	anonymousAuditInterval time.Duration
+
+	l logger.Interface
 }
 
 // New - make user usecase.
-func New(repo UserRepo, webapi VkWebApi, tokenSecret string, tokenTTL time.Duration, privateKey string) *UserUseCase {
-	return &UserUseCase{
+func New(repo UserRepo, webapi VkWebApi, tokenSecret string, tokenTTL time.Duration, privateKey string, opts ...Option) *UserUseCase {
+	u := &UserUseCase{
 		repo:        repo,
 		api:         webapi,
 		tokenSecret: tokenSecret,
 		tokenTTL:    tokenTTL,
 		privateKey:  privateKey,
 	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
 }
 
 func (u *UserUseCase) CreateAccount(ctx context.Context, email, password string) error {
@@ -56,9 +84,13 @@ func (u *UserUseCase) CreateAccount(ctx context.Context, email, password string)
 }
 
 func (u *UserUseCase) Login(ctx context.Context, email, password string) (*entity.User, string, error) {
+	metadata := map[string]any{"provider": "password", "email": email}
+
 	user, err := u.repo.User(ctx, email)
 	if err != nil {
 		if errors.Is(err, entity.ErrUserNotFound) {
+			u.auditLogin(ctx, 0, entity.ErrUserNotFound, metadata)
+
 			return nil, "", entity.ErrUserNotFound
 		}
 
@@ -66,22 +98,35 @@ func (u *UserUseCase) Login(ctx context.Context, email, password string) (*entit
 	}
 
 	if err := bcrypt.CompareHashAndPassword(user.PassHash, []byte(password)); err != nil {
+		u.auditLogin(ctx, user.ID, entity.ErrInvalidCredentials, metadata)
+
 		return nil, "", entity.ErrInvalidCredentials
 	}
 
-	token, err := u.doToken(user.ID, user.Role)
+	if err := statusError(user); err != nil {
+		u.auditLogin(ctx, user.ID, err, metadata)
+
+		return nil, "", err
+	}
+
+	token, err := u.doToken(ctx, user)
 	if err != nil {
 		return nil, "", fmt.Errorf("can't make token: %w", err)
 	}
 
+	u.auditLogin(ctx, user.ID, nil, metadata)
+
 	return user, token, nil
 }
 
 func (u *UserUseCase) VkLoginByAccessToken(ctx context.Context, userAccessToken string) (*entity.User, string, error) {
-	tokenInfo, err := u.api.ValidateUserAccessToken(userAccessToken)
-	if err != nil {
+	tokenInfo, err := u.api.ValidateUserAccessToken(ctx, userAccessToken)
+	if errors.Is(err, entity.ErrBadVkToken) || errors.Is(err, entity.ErrVkTokenExpired) {
 		return nil, "", err
 	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed validate vk access token: %w", err)
+	}
 
 	return u.doVkLogin(ctx, tokenInfo.UserId)
 }
@@ -102,45 +147,289 @@ func (u *UserUseCase) VkLogin(ctx context.Context, launchParams string) (*entity
 		return nil, "", entity.ErrBadVkLaunchParams
 	}
 
+	if err := u.checkLaunchParamsFreshness(queryMap); err != nil {
+		return nil, "", err
+	}
+
 	vkUserIDParsed, err := strconv.Atoi(queryParams.Get("vk_user_id"))
 
 	if err != nil {
 		return nil, "", entity.ErrBadVkLaunchParams
 	}
-	return u.doVkLogin(ctx, vkUserIDParsed)
+
+	sign := queryMap["sign"]
+	if u.launchParamsReplay != nil {
+		// pushing out alive signature would make it usable again, so logins are refused instead
+		added, err := u.launchParamsReplay.AddLive(sign, struct{}{}, u.launchParamsTTL)
+		if errors.Is(err, cache.ErrFull) {
+			return nil, "", entity.ErrTooManyVkLaunches
+		}
+		if !added {
+			return nil, "", entity.ErrVkLaunchParamsReplayed
+		}
+	}
+
+	user, token, err := u.doVkLaunchLogin(ctx, vkUserIDParsed, queryMap)
+	if err != nil {
+		// failed login doesn't burn params, so client is able to retry them
+		if u.launchParamsReplay != nil {
+			u.launchParamsReplay.Delete(sign)
+		}
+
+		return nil, "", err
+	}
+
+	return user, token, nil
+}
+
+func (u *UserUseCase) doVkLaunchLogin(ctx context.Context, vkID int, query map[string]string) (*entity.User, string, error) {
+	user, token, err := u.doVkLogin(ctx, vkID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = u.repo.SaveVkLaunchContext(ctx, user.ID, parseLaunchContext(query))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed save vk launch context: %w", err)
+	}
+
+	return user, token, nil
+}
+
+func parseLaunchContext(query map[string]string) entity.VkLaunchContext {
+	groupID, _ := strconv.ParseInt(query["vk_group_id"], 10, 64)
+
+	return entity.VkLaunchContext{
+		Platform:                query["vk_platform"],
+		Language:                query["vk_language"],
+		IsAppUser:               query["vk_is_app_user"] == "1",
+		AreNotificationsEnabled: query["vk_are_notifications_enabled"] == "1",
+		IsFavorite:              query["vk_is_favorite"] == "1",
+		Ref:                     query["vk_ref"],
+		GroupID:                 groupID,
+		ViewerGroupRole:         query["vk_viewer_group_role"],
+		ChatID:                  query["vk_chat_id"],
+		LaunchedAt:              time.Now().UTC(),
+	}
+}
+
+// checkLaunchParamsFreshness - signed params must belong to our app and be not older than launchParamsTTL.
+func (u *UserUseCase) checkLaunchParamsFreshness(query map[string]string) error {
+	if u.vkAppID != 0 && query["vk_app_id"] != strconv.Itoa(u.vkAppID) {
+		return entity.ErrBadVkLaunchParams
+	}
+
+	if u.launchParamsTTL == 0 {
+		return nil
+	}
+
+	vkTs, err := strconv.ParseInt(query["vk_ts"], 10, 64)
+	if err != nil {
+		return entity.ErrBadVkLaunchParams
+	}
+
+	launchedAt := time.Unix(vkTs, 0)
+	if time.Since(launchedAt) > u.launchParamsTTL {
+		return entity.ErrVkLaunchParamsExpired
+	}
+	if time.Until(launchedAt) > time.Minute {
+		return entity.ErrBadVkLaunchParams
+	}
+
+	return nil
+}
+
+func (u *UserUseCase) TelegramLogin(ctx context.Context, data entity.TelegramAuthData) (*entity.User, string, error) {
+	// Hash with empty bot token is computable by anyone
+	if u.telegramBotToken == "" {
+		return nil, "", entity.ErrTelegramDisabled
+	}
+
+	if !u.verifyTelegramAuthData(data) {
+		return nil, "", entity.ErrBadTelegramAuthData
+	}
+
+	authDate := time.Unix(data.AuthDate, 0)
+	if time.Since(authDate) > u.telegramAuthTTL {
+		return nil, "", entity.ErrTelegramAuthDataExpired
+	}
+	if time.Until(authDate) > time.Minute {
+		return nil, "", entity.ErrBadTelegramAuthData
+	}
+
+	return u.doSocialLogin(ctx, entity.TelegramProvider, strconv.FormatInt(data.ID, 10))
+}
+
+func (u *UserUseCase) doVkLogin(ctx context.Context, vkID int) (*entity.User, string, error) {
+	return u.doSocialLogin(ctx, entity.VkProvider, strconv.Itoa(vkID))
+}
+
+// refreshProfile - update upstream profile of logged in user in background, only VK one is stored for now.
+// Login doesn't wait for it, so refresh has own short deadline detached from the request.
+func (u *UserUseCase) refreshProfile(ctx context.Context, userID uint64, provider, providerID string) {
+	if provider != entity.VkProvider {
+		return
+	}
+
+	vkID, err := strconv.Atoi(providerID)
+	if err != nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), _profileRefreshTimeout)
+		defer cancel()
+
+		if err := u.refreshVkUserInfo(ctx, userID, vkID); err != nil && u.l != nil {
+			u.l.Warn("usecase - refreshProfile - refreshVkUserInfo: %s", err)
+		}
+	}()
 }
 
-func (u *UserUseCase) doVkLogin(ctx context.Context, userID int) (*entity.User, string, error) {
-	user, err := u.repo.SocialUser(ctx, "vk", strconv.Itoa(userID))
+// refreshVkUserInfo - fetch VK profile by users.get if it's absent or older than vkProfileRefresh.
+func (u *UserUseCase) refreshVkUserInfo(ctx context.Context, userID uint64, vkID int) error {
+	info, err := u.repo.VkUserInfo(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("can't get vk user info: %w", err)
+	}
+	if info != nil && time.Since(info.UpdatedAt) < u.vkProfileRefresh {
+		return nil
+	}
+
+	info, err = u.api.User(ctx, vkID)
+	if err != nil {
+		return fmt.Errorf("can't fetch vk user info: %w", err)
+	}
+
+	if err := u.repo.SaveVkUserInfo(ctx, userID, *info); err != nil {
+		return fmt.Errorf("can't save vk user info: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserUseCase) doSocialLogin(ctx context.Context, provider, providerID string) (*entity.User, string, error) {
+	metadata := map[string]any{"provider": provider, "providerId": providerID}
+
+	user, err := u.repo.SocialUser(ctx, provider, providerID)
 	if errors.Is(err, entity.ErrUserNotFound) {
-		user, err := u.repo.SaveSocialUser(ctx, "vk", strconv.Itoa(userID))
+		user, err := u.repo.SaveSocialUser(ctx, provider, providerID)
+		if errors.Is(err, entity.ErrUserExists) {
+			// lost the race of the first login, user is registered by concurrent request
+			return u.doSocialLogin(ctx, provider, providerID)
+		}
 		if err != nil {
 			return nil, "", fmt.Errorf("failed save social login: %w", err)
 		}
 
-		token, err := u.doToken(user.ID, user.Role)
+		token, err := u.doToken(ctx, user)
 		if err != nil {
 			return nil, "", fmt.Errorf("can't make token: %w", err)
 		}
 
+		metadata["registered"] = true
+		u.auditLogin(ctx, user.ID, nil, metadata)
+		u.refreshProfile(ctx, user.ID, provider, providerID)
+
 		return user, token, nil
 	}
+	if errors.Is(err, entity.ErrUserDeleted) {
+		return nil, "", err
+	}
 	if err != nil {
 		return nil, "", fmt.Errorf("failed get user by social login: %w", err)
 	}
 
-	token, err := u.doToken(user.ID, user.Role)
+	if err := statusError(user); err != nil {
+		u.auditLogin(ctx, user.ID, err, metadata)
+
+		return nil, "", err
+	}
+
+	token, err := u.doToken(ctx, user)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed make token: %w", err)
 	}
 
+	u.auditLogin(ctx, user.ID, nil, metadata)
+	u.refreshProfile(ctx, user.ID, provider, providerID)
+
 	return user, token, nil
 }
 
-func (u *UserUseCase) doToken(userId uint64, role entity.Role) (string, error) {
+// auditLogin - failed audit write doesn't fail login, it's only logged. loginErr is reason of refusal.
+// Failures of unknown users (userID 0) are limited per IP, so guessing emails doesn't flood the chain.
+func (u *UserUseCase) auditLogin(ctx context.Context, userID uint64, loginErr error, metadata map[string]any) {
+	if userID == 0 && u.anonymousAudits != nil &&
+		!u.anonymousAudits.Add(reqmeta.FromContext(ctx).IP, struct{}{}, u.anonymousAuditInterval) {
+		return
+	}
+
+	outcome := entity.SuccessOutcome
+	if loginErr != nil {
+		outcome = entity.FailureOutcome
+		metadata["reason"] = loginErr.Error()
+	}
+
+	err := u.repo.SaveAuditEvent(ctx, newAuditEvent(ctx, userID, userID, entity.LoginAction, outcome, metadata))
+	if err != nil && u.l != nil {
+		u.l.Error(fmt.Errorf("usecase - auditLogin: %w", err))
+	}
+}
+
+// TokenValid - token is revoked when its version is behind the user one, e.g. after role change,
+// when user is blocked or when permissions of user role were changed after token issue.
+func (u *UserUseCase) TokenValid(ctx context.Context, userID, tokenVersion, permsVersion uint64) (bool, error) {
+	user, err := u.repo.UserByID(ctx, userID)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("can't get user: %w", err)
+	}
+
+	if user.TokenVersion != tokenVersion || user.Blocked(time.Now()) {
+		return false, nil
+	}
+
+	// Token of role without permissions is issued with zero version
+	roleInfo, err := u.repo.Role(ctx, user.Role)
+	if err != nil && !errors.Is(err, entity.ErrRoleNotFound) {
+		return false, fmt.Errorf("can't get role: %w", err)
+	}
+
+	return permsVersion >= roleInfo.Version, nil
+}
+
+// statusError - reason of login refusal for blocked user.
+func statusError(user *entity.User) error {
+	if !user.Blocked(time.Now()) {
+		return nil
+	}
+	if user.Status == entity.SuspendedStatus {
+		return entity.ErrUserSuspended
+	}
+
+	return entity.ErrUserBanned
+}
+
+func (u *UserUseCase) doToken(ctx context.Context, user *entity.User) (string, error) {
+	roleInfo, err := u.repo.Role(ctx, user.Role)
+	if err != nil && !errors.Is(err, entity.ErrRoleNotFound) {
+		return "", fmt.Errorf("can't get role permissions: %w", err)
+	}
+
+	perms := make([]string, 0, len(roleInfo.Permissions))
+	for _, perm := range roleInfo.Permissions {
+		perms = append(perms, string(perm))
+	}
+
 	payload := map[string]any{
-		"uid":  userId,
-		"role": role,
+		"uid":          user.ID,
+		"role":         user.Role,
+		"perms":        perms,
+		"permsVersion": roleInfo.Version,
+		"tokenVersion": user.TokenVersion,
 	}
 
 	token, err := jwt.NewToken(payload, u.tokenSecret, u.tokenTTL)
@@ -183,3 +472,42 @@ func (u *UserUseCase) verifyLaunchParams(query map[string]string) bool {
 
 	return query["sign"] == decodedHashCode
 }
+
+// verifyTelegramAuthData checks the widget hash: HMAC-SHA256 of the sorted data-check-string
+// keyed with SHA256 of the bot token.
+func (u *UserUseCase) verifyTelegramAuthData(data entity.TelegramAuthData) bool {
+	if u.telegramBotToken == "" || data.Hash == "" {
+		return false
+	}
+
+	fields := map[string]string{
+		"id":         strconv.FormatInt(data.ID, 10),
+		"first_name": data.FirstName,
+		"last_name":  data.LastName,
+		"username":   data.Username,
+		"photo_url":  data.PhotoURL,
+		"auth_date":  strconv.FormatInt(data.AuthDate, 10),
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k, v := range fields {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var checkParams []string
+	for _, k := range keys {
+		checkParams = append(checkParams, fmt.Sprintf("%s=%s", k, fields[k]))
+	}
+	checkString := strings.Join(checkParams, "\n")
+
+	secretKey := sha256.Sum256([]byte(u.telegramBotToken))
+
+	h := hmac.New(sha256.New, secretKey[:])
+	h.Write([]byte(checkString))
+	hashCode := hex.EncodeToString(h.Sum(nil))
+
+	return hmac.Equal([]byte(hashCode), []byte(strings.ToLower(data.Hash)))
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+func TestVerifyLaunchParams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secret = "wvl68m4dR1UpLrVRli"
+		params = "vk_access_token_settings=notify&vk_app_id=6736218&vk_are_notifications_enabled=0&vk_is_app_user=0" +
+			"&vk_is_favorite=0&vk_language=ru&vk_platform=android&vk_ref=other&vk_user_id=494075"
+		sign = "hfZHwlNXN8CYDi3pGdvyS803uMLQLMYgT3WDO7LlOdw"
+	)
+
+	tests := []struct {
+		name   string
+		query  string
+		secret string
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			query:  params + "&sign=" + sign,
+			secret: secret,
+			valid:  true,
+		},
+		{
+			name: "params order doesn't matter",
+			query: "vk_user_id=494075&sign=" + sign + "&vk_ref=other&vk_platform=android&vk_language=ru&vk_is_favorite=0" +
+				"&vk_is_app_user=0&vk_are_notifications_enabled=0&vk_app_id=6736218&vk_access_token_settings=notify",
+			secret: secret,
+			valid:  true,
+		},
+		{
+			name:   "foreign params aren't signed",
+			query:  params + "&utm_source=test&sign=" + sign,
+			secret: secret,
+			valid:  true,
+		},
+		{
+			name:   "changed user",
+			query:  strings.Replace(params, "vk_user_id=494075", "vk_user_id=494076", 1) + "&sign=" + sign,
+			secret: secret,
+			valid:  false,
+		},
+		{
+			name:   "added vk param",
+			query:  params + "&vk_ts=1700000000&sign=" + sign,
+			secret: secret,
+			valid:  false,
+		},
+		{
+			name:   "another secret",
+			query:  params + "&sign=" + sign,
+			secret: "another-secret",
+			valid:  false,
+		},
+		{
+			name:   "no sign",
+			query:  params,
+			secret: secret,
+			valid:  false,
+		},
+		{
+			name:   "padded sign",
+			query:  params + "&sign=" + sign + "=",
+			secret: secret,
+			valid:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := url.ParseQuery(tc.query)
+			if err != nil {
+				t.Fatalf("can't parse query: %s", err)
+			}
+
+			query := make(map[string]string, len(values))
+			for k, v := range values {
+				query[k] = v[0]
+			}
+
+			u := &UserUseCase{privateKey: tc.secret}
+			if got := u.verifyLaunchParams(query); got != tc.valid {
+				t.Errorf("verifyLaunchParams = %t, want %t", got, tc.valid)
+			}
+		})
+	}
+}
+
+func TestVerifyTelegramAuthData(t *testing.T) {
+	t.Parallel()
+
+	const botToken = "123456:ABC-DEF1234ghIkl-zyx57W2v1u123ew11"
+
+	// data-check-string "auth_date=1700000000\nfirst_name=Ivan\nid=12345678\nusername=ivan"
+	valid := entity.TelegramAuthData{
+		ID:        12345678,
+		FirstName: "Ivan",
+		Username:  "ivan",
+		AuthDate:  1700000000,
+		Hash:      "3a352d9139616d30249a192123d932e33a9ed30d65c85614aebe35ff0f60dfea",
+	}
+
+	tests := []struct {
+		name     string
+		botToken string
+		data     func(d entity.TelegramAuthData) entity.TelegramAuthData
+		valid    bool
+	}{
+		{
+			name:     "valid",
+			botToken: botToken,
+			valid:    true,
+		},
+		{
+			name:     "upper case hash",
+			botToken: botToken,
+			data: func(d entity.TelegramAuthData) entity.TelegramAuthData {
+				d.Hash = "3A352D9139616D30249A192123D932E33A9ED30D65C85614AEBE35FF0F60DFEA"
+
+				return d
+			},
+			valid: true,
+		},
+		{
+			name:     "changed id",
+			botToken: botToken,
+			data: func(d entity.TelegramAuthData) entity.TelegramAuthData {
+				d.ID++
+
+				return d
+			},
+		},
+		{
+			name:     "changed auth date",
+			botToken: botToken,
+			data: func(d entity.TelegramAuthData) entity.TelegramAuthData {
+				d.AuthDate++
+
+				return d
+			},
+		},
+		{
+			name:     "added field",
+			botToken: botToken,
+			data: func(d entity.TelegramAuthData) entity.TelegramAuthData {
+				d.LastName = "Petrov"
+
+				return d
+			},
+		},
+		{
+			name:     "another bot",
+			botToken: "654321:another-token",
+		},
+		{
+			name: "empty bot token",
+		},
+		{
+			name:     "empty hash",
+			botToken: botToken,
+			data: func(d entity.TelegramAuthData) entity.TelegramAuthData {
+				d.Hash = ""
+
+				return d
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := valid
+			if tc.data != nil {
+				data = tc.data(data)
+			}
+
+			u := &UserUseCase{telegramBotToken: tc.botToken}
+			if got := u.verifyTelegramAuthData(data); got != tc.valid {
+				t.Errorf("verifyTelegramAuthData = %t, want %t", got, tc.valid)
+			}
+		})
+	}
+}
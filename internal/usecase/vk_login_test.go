@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase"
+)
+
+const (
+	_vkAppID     = 6736218
+	_vkAppSecret = "wvl68m4dR1UpLrVRli"
+)
+
+// launchParams - signed launch params of VK mini app, url.Values.Encode sorts keys as VK does.
+func launchParams(vkUserID int, vkTs time.Time, appID int) string {
+	params := url.Values{}
+	params.Set("vk_app_id", strconv.Itoa(appID))
+	params.Set("vk_user_id", strconv.Itoa(vkUserID))
+	params.Set("vk_platform", "mobile_android")
+	params.Set("vk_ts", strconv.FormatInt(vkTs.Unix(), 10))
+
+	h := hmac.New(sha256.New, []byte(_vkAppSecret))
+	h.Write([]byte(params.Encode()))
+	params.Set("sign", base64.RawURLEncoding.EncodeToString(h.Sum(nil)))
+
+	return "https://vk.com/app?" + params.Encode()
+}
+
+// vkLoginRepo - repo where every VK user is already registered and logins succeed.
+func vkLoginRepo(ctrl *gomock.Controller) *MockUserRepo {
+	repo := NewMockUserRepo(ctrl)
+
+	repo.EXPECT().SocialUser(gomock.Any(), entity.VkProvider, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, providerID string) (*entity.User, error) {
+			id, _ := strconv.ParseUint(providerID, 10, 64)
+
+			return &entity.User{ID: id, Role: entity.UserRole, Status: entity.ActiveStatus}, nil
+		}).AnyTimes()
+	repo.EXPECT().Role(gomock.Any(), gomock.Any()).Return(entity.RoleInfo{}, nil).AnyTimes()
+	repo.EXPECT().SaveAuditEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().SaveVkLaunchContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	// profile is refreshed in background
+	repo.EXPECT().VkUserInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("skipped")).AnyTimes()
+
+	return repo
+}
+
+func TestVkLoginFreshness(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		params string
+		err    error
+	}{
+		{
+			name:   "fresh",
+			params: launchParams(1, now, _vkAppID),
+		},
+		{
+			name:   "almost expired",
+			params: launchParams(1, now.Add(-time.Hour+time.Minute), _vkAppID),
+		},
+		{
+			name:   "stale vk_ts",
+			params: launchParams(1, now.Add(-time.Hour-time.Minute), _vkAppID),
+			err:    entity.ErrVkLaunchParamsExpired,
+		},
+		{
+			name:   "vk_ts from future",
+			params: launchParams(1, now.Add(time.Hour), _vkAppID),
+			err:    entity.ErrBadVkLaunchParams,
+		},
+		{
+			name:   "another app",
+			params: launchParams(1, now, _vkAppID+1),
+			err:    entity.ErrBadVkLaunchParams,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			u := usecase.New(vkLoginRepo(ctrl), nil, "jwt-secret", time.Hour, _vkAppSecret,
+				usecase.VkLaunchParams(_vkAppID, time.Hour, 0),
+			)
+
+			_, _, err := u.VkLogin(context.Background(), tc.params)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("VkLogin error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestVkLoginReplay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		cacheSize int
+		logins    []string
+		err       error
+	}{
+		{
+			name:      "replayed signature",
+			cacheSize: 10,
+			logins:    []string{launchParams(1, now, _vkAppID), launchParams(1, now, _vkAppID)},
+			err:       entity.ErrVkLaunchParamsReplayed,
+		},
+		{
+			name:      "another launch of the same user",
+			cacheSize: 10,
+			logins:    []string{launchParams(1, now.Add(-time.Second), _vkAppID), launchParams(1, now, _vkAppID)},
+		},
+		{
+			name:      "full cache doesn't forget alive signatures",
+			cacheSize: 2,
+			logins: []string{
+				launchParams(1, now, _vkAppID), launchParams(2, now, _vkAppID), launchParams(3, now, _vkAppID),
+			},
+			err: entity.ErrTooManyVkLaunches,
+		},
+		{
+			name:      "rejected login doesn't take cache place",
+			cacheSize: 1,
+			logins: []string{
+				launchParams(1, now, _vkAppID), launchParams(2, now.Add(-2*time.Hour), _vkAppID),
+				launchParams(1, now, _vkAppID),
+			},
+			err: entity.ErrVkLaunchParamsReplayed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			u := usecase.New(vkLoginRepo(ctrl), nil, "jwt-secret", time.Hour, _vkAppSecret,
+				usecase.VkLaunchParams(_vkAppID, time.Hour, tc.cacheSize),
+			)
+
+			var err error
+			for i, params := range tc.logins {
+				_, _, err = u.VkLogin(context.Background(), params)
+				if err != nil && i < len(tc.logins)-1 && !errors.Is(err, entity.ErrVkLaunchParamsExpired) {
+					t.Fatalf("login %d: %s", i, err)
+				}
+			}
+
+			if !errors.Is(err, tc.err) {
+				t.Errorf("last VkLogin error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestVkLoginFailureKeepsParamsUsable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	repo := NewMockUserRepo(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().SocialUser(gomock.Any(), entity.VkProvider, "1").Return(nil, errors.New("db is down")),
+		repo.EXPECT().SocialUser(gomock.Any(), entity.VkProvider, "1").
+			Return(&entity.User{ID: 1, Role: entity.UserRole, Status: entity.ActiveStatus}, nil),
+	)
+	repo.EXPECT().Role(gomock.Any(), gomock.Any()).Return(entity.RoleInfo{}, nil)
+	repo.EXPECT().SaveAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().SaveVkLaunchContext(gomock.Any(), uint64(1), gomock.Any()).Return(nil)
+	repo.EXPECT().VkUserInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("skipped")).AnyTimes()
+
+	u := usecase.New(repo, nil, "jwt-secret", time.Hour, _vkAppSecret,
+		usecase.VkLaunchParams(_vkAppID, time.Hour, 10),
+	)
+
+	params := launchParams(1, time.Now(), _vkAppID)
+
+	if _, _, err := u.VkLogin(context.Background(), params); err == nil {
+		t.Fatal("VkLogin succeeded with failed repo")
+	}
+	if _, _, err := u.VkLogin(context.Background(), params); err != nil {
+		t.Fatalf("retry of failed VkLogin: %s", err)
+	}
+}
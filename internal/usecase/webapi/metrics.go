@@ -0,0 +1,65 @@
+package webapi
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/VmesteApp/auth-service/pkg/breaker"
+)
+
+var (
+	vkCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of VK API calls including retries.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	vkCallErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "errors_total",
+		Help:      "VK API call attempt errors by VK error_code or transport failure.",
+	}, []string{"method", "code"})
+
+	vkCallRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "retries_total",
+		Help:      "Retried VK API call attempts.",
+	}, []string{"method"})
+
+	vkTokenCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "token_cache_requests_total",
+		Help:      "Lookups of validated VK access tokens cache by result: hit or miss.",
+	}, []string{"result"})
+
+	vkTokenCacheEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "token_cache_evictions_total",
+		Help:      "Validated VK access tokens pushed out of cache by size limit.",
+	})
+
+	vkTokenCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "token_cache_size",
+		Help:      "Validated VK access tokens in cache.",
+	})
+
+	vkBreakerState = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "auth",
+		Subsystem: "vk_api",
+		Name:      "circuit_breaker_state",
+		Help:      "VK API circuit breaker state: 0 - closed, 1 - half-open, 2 - open.",
+	})
+)
+
+// ObserveBreakerState - breaker.OnStateChange hook exporting state to metrics.
+func ObserveBreakerState(state breaker.State) {
+	vkBreakerState.Set(float64(state))
+}
@@ -0,0 +1,294 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/VmesteApp/auth-service/config"
+	"github.com/VmesteApp/auth-service/internal/entity"
+)
+
+const _defaultOAuthTimeout = 10 * time.Second
+
+// identityFunc extracts upstream user identity by the exchanged token.
+type identityFunc func(ctx context.Context, client *http.Client, token *oauth2.Token) (*entity.OAuthIdentity, error)
+
+// exchangeParamsFunc picks provider specific callback params required by token endpoint.
+type exchangeParamsFunc func(params url.Values) []oauth2.AuthCodeOption
+
+// OAuthProvider implements authorization code flow with PKCE for one upstream.
+type OAuthProvider struct {
+	name           string
+	id             string
+	config         oauth2.Config
+	client         *http.Client
+	identity       identityFunc
+	exchangeParams exchangeParamsFunc
+}
+
+// NewOAuthProvider - make provider by its config type (oidc, google, yandex, vkid).
+func NewOAuthProvider(ctx context.Context, cfg config.OAuthProvider) (*OAuthProvider, error) {
+	p := &OAuthProvider{
+		name: cfg.Name,
+		config: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+		},
+		client: &http.Client{Timeout: _defaultOAuthTimeout},
+	}
+
+	var err error
+
+	switch cfg.Type {
+	case "oidc":
+		p.id = "oidc:" + strings.TrimRight(cfg.Issuer, "/")
+		err = p.withOIDC(ctx, cfg)
+	case "google":
+		p.id = "google"
+		cfg.Issuer = "https://accounts.google.com"
+		err = p.withOIDC(ctx, cfg)
+	case "yandex":
+		p.id = "yandex"
+		p.withYandex()
+	case "vkid":
+		// VK ID users are the same as VK Mini Apps ones
+		p.id = entity.VkProvider
+		p.withVkID()
+	default:
+		err = fmt.Errorf("unknown provider type %q", cfg.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't init oauth provider %s: %w", cfg.Name, err)
+	}
+
+	return p, nil
+}
+
+// ID - stable provider identifier stored with social logins, it doesn't depend on config name.
+func (p *OAuthProvider) ID() string {
+	return p.id
+}
+
+// AuthCodeURL - URL of upstream consent page.
+func (p *OAuthProvider) AuthCodeURL(state, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+}
+
+// Exchange - exchange authorization code and extract upstream identity.
+func (p *OAuthProvider) Exchange(ctx context.Context, code, codeVerifier string, params url.Values) (*entity.OAuthIdentity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	opts := []oauth2.AuthCodeOption{oauth2.VerifierOption(codeVerifier)}
+	if p.exchangeParams != nil {
+		opts = append(opts, p.exchangeParams(params)...)
+	}
+
+	token, err := p.config.Exchange(ctx, code, opts...)
+	if err != nil {
+		return nil, p.exchangeError(err)
+	}
+
+	identity, err := p.identity(ctx, p.client, token)
+	if err != nil {
+		return nil, fmt.Errorf("can't get %s identity: %w", p.name, err)
+	}
+
+	identity.Provider = p.id
+
+	return identity, nil
+}
+
+func (p *OAuthProvider) exchangeError(err error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant" {
+		return entity.ErrBadOAuthCode
+	}
+
+	return fmt.Errorf("can't exchange %s code: %w", p.name, err)
+}
+
+type oidcDiscovery struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type oidcUserInfo struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+func (p *OAuthProvider) withOIDC(ctx context.Context, cfg config.OAuthProvider) error {
+	if cfg.AuthURL == "" || cfg.TokenURL == "" || cfg.UserInfoURL == "" {
+		var discovery oidcDiscovery
+
+		discoveryURL := strings.TrimRight(cfg.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := getJSON(ctx, p.client, discoveryURL, "", &discovery); err != nil {
+			return fmt.Errorf("can't discover oidc endpoints: %w", err)
+		}
+
+		cfg.AuthURL = firstNonEmpty(cfg.AuthURL, discovery.AuthorizationEndpoint)
+		cfg.TokenURL = firstNonEmpty(cfg.TokenURL, discovery.TokenEndpoint)
+		cfg.UserInfoURL = firstNonEmpty(cfg.UserInfoURL, discovery.UserinfoEndpoint)
+	}
+
+	p.config.Endpoint = oauth2.Endpoint{AuthURL: cfg.AuthURL, TokenURL: cfg.TokenURL}
+	if len(p.config.Scopes) == 0 {
+		p.config.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	p.identity = func(ctx context.Context, client *http.Client, token *oauth2.Token) (*entity.OAuthIdentity, error) {
+		var info oidcUserInfo
+		if err := getJSON(ctx, client, cfg.UserInfoURL, "Bearer "+token.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.Sub == "" {
+			return nil, errors.New("userinfo has no sub")
+		}
+
+		identity := &entity.OAuthIdentity{ProviderID: info.Sub, Name: info.Name}
+		if info.EmailVerified {
+			identity.Email = info.Email
+		}
+
+		return identity, nil
+	}
+
+	return nil
+}
+
+type yandexUserInfo struct {
+	ID           string `json:"id"`
+	DefaultEmail string `json:"default_email"`
+	RealName     string `json:"real_name"`
+}
+
+func (p *OAuthProvider) withYandex() {
+	p.config.Endpoint = oauth2.Endpoint{
+		AuthURL:   "https://oauth.yandex.ru/authorize",
+		TokenURL:  "https://oauth.yandex.ru/token",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+
+	p.identity = func(ctx context.Context, client *http.Client, token *oauth2.Token) (*entity.OAuthIdentity, error) {
+		var info yandexUserInfo
+		if err := getJSON(ctx, client, "https://login.yandex.ru/info?format=json", "OAuth "+token.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.ID == "" {
+			return nil, errors.New("yandex info has no id")
+		}
+
+		return &entity.OAuthIdentity{ProviderID: info.ID, Email: info.DefaultEmail, Name: info.RealName}, nil
+	}
+}
+
+type vkIDUserInfo struct {
+	User struct {
+		UserID    string `json:"user_id"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Email     string `json:"email"`
+	} `json:"user"`
+}
+
+// withVkID - VK ID (id.vk.com) web flow, user_id is the same as in VK Mini Apps.
+func (p *OAuthProvider) withVkID() {
+	p.config.Endpoint = oauth2.Endpoint{
+		AuthURL:   "https://id.vk.com/authorize",
+		TokenURL:  "https://id.vk.com/oauth2/auth",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+
+	p.exchangeParams = func(params url.Values) []oauth2.AuthCodeOption {
+		return []oauth2.AuthCodeOption{
+			oauth2.SetAuthURLParam("device_id", params.Get("device_id")),
+			oauth2.SetAuthURLParam("state", params.Get("state")),
+		}
+	}
+
+	p.identity = func(ctx context.Context, client *http.Client, token *oauth2.Token) (*entity.OAuthIdentity, error) {
+		form := url.Values{}
+		form.Set("client_id", p.config.ClientID)
+		form.Set("access_token", token.AccessToken)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://id.vk.com/oauth2/user_info", strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, fmt.Errorf("can't make request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var info vkIDUserInfo
+		if err := doJSON(client, req, &info); err != nil {
+			return nil, err
+		}
+
+		if _, err := strconv.Atoi(info.User.UserID); err != nil {
+			return nil, fmt.Errorf("vk id user_info has bad user_id %q", info.User.UserID)
+		}
+
+		return &entity.OAuthIdentity{
+			ProviderID: info.User.UserID,
+			Email:      info.User.Email,
+			Name:       strings.TrimSpace(info.User.FirstName + " " + info.User.LastName),
+		}, nil
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, rawURL, authorization string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("can't make request: %w", err)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return doJSON(client, req, dst)
+}
+
+func doJSON(client *http.Client, req *http.Request, dst any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("can't request %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("can't read body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Path)
+	}
+
+	if err := json.Unmarshal(body, dst); err != nil {
+		return fmt.Errorf("can't unmarshal body: %w", err)
+	}
+
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,72 @@
+package webapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/breaker"
+	"github.com/VmesteApp/auth-service/pkg/cache"
+)
+
+// Option -.
+type Option func(*VkWebApi)
+
+// BaseURL -.
+func BaseURL(baseURL string) Option {
+	return func(vk *VkWebApi) {
+		vk.baseURL = baseURL
+	}
+}
+
+// Version -.
+func Version(version string) Option {
+	return func(vk *VkWebApi) {
+		vk.version = version
+	}
+}
+
+// Timeout -.
+func Timeout(timeout time.Duration) Option {
+	return func(vk *VkWebApi) {
+		vk.client.Timeout = timeout
+	}
+}
+
+// HTTPClient -.
+func HTTPClient(client *http.Client) Option {
+	return func(vk *VkWebApi) {
+		vk.client = client
+	}
+}
+
+// Retry - attempts per call including the first one, backoff is the base of jittered exponential delay.
+func Retry(attempts int, backoff time.Duration) Option {
+	return func(vk *VkWebApi) {
+		vk.attempts = max(attempts, 1)
+		vk.retryBackoff = backoff
+	}
+}
+
+// CircuitBreaker -.
+func CircuitBreaker(b *breaker.Breaker) Option {
+	return func(vk *VkWebApi) {
+		vk.breaker = b
+	}
+}
+
+// TokenCache - remember validated access tokens for ttl (bounded by token expire), 0 maxSize disables cache.
+func TokenCache(maxSize int, ttl time.Duration) Option {
+	return func(vk *VkWebApi) {
+		if maxSize <= 0 {
+			vk.tokenCache = nil
+
+			return
+		}
+
+		vk.tokenCache = cache.New(maxSize, func(string, entity.VkTokenInfo) {
+			vkTokenCacheEvictions.Inc()
+		})
+		vk.tokenCacheTTL = ttl
+	}
+}
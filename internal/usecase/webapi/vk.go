@@ -1,69 +1,417 @@
 package webapi
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/pkg/breaker"
+	"github.com/VmesteApp/auth-service/pkg/cache"
 )
 
+const (
+	_defaultBaseURL      = "https://api.vk.com"
+	_defaultVersion      = "5.101"
+	_defaultTimeout      = 5 * time.Second
+	_defaultAttempts     = 3
+	_defaultRetryBackoff = 100 * time.Millisecond
+	_maxRetryBackoff     = 2 * time.Second
+)
+
+// VK API error codes, see https://dev.vk.com/ru/reference/errors.
+const (
+	_vkErrUnknown          = 1
+	_vkErrAuthFailed       = 5
+	_vkErrTooManyRequests  = 6
+	_vkErrFloodControl     = 9
+	_vkErrInternal         = 10
+	_vkErrAccessDenied     = 15
+	_vkErrRateLimitReached = 29
+)
+
+// APIError - error returned by VK API method.
+type APIError struct {
+	Code int    `json:"error_code"`
+	Msg  string `json:"error_msg"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("vk api error %d: %s", e.Code, e.Msg)
+}
+
+// Temporary - error is caused by VK side and the request may succeed later.
+func (e *APIError) Temporary() bool {
+	switch e.Code {
+	case _vkErrUnknown, _vkErrTooManyRequests, _vkErrFloodControl, _vkErrInternal, _vkErrRateLimitReached:
+		return true
+	default:
+		return false
+	}
+}
+
 type VkWebApi struct {
 	AppId         int
 	ServiceSecret string
+
+	baseURL      string
+	version      string
+	client       *http.Client
+	attempts     int
+	retryBackoff time.Duration
+	breaker      *breaker.Breaker
+
+	tokenCache    *cache.Cache[string, entity.VkTokenInfo]
+	tokenCacheTTL time.Duration
 }
 
-func New(appId int, serviceSecret string) *VkWebApi {
-	return &VkWebApi{
+func New(appId int, serviceSecret string, opts ...Option) *VkWebApi {
+	vk := &VkWebApi{
 		AppId:         appId,
 		ServiceSecret: serviceSecret,
+		baseURL:       _defaultBaseURL,
+		version:       _defaultVersion,
+		client:        &http.Client{Timeout: _defaultTimeout},
+		attempts:      _defaultAttempts,
+		retryBackoff:  _defaultRetryBackoff,
+		breaker:       breaker.New(breaker.OnStateChange(ObserveBreakerState)),
+	}
+
+	for _, opt := range opts {
+		opt(vk)
+	}
+
+	return vk
+}
+
+type apiResponse struct {
+	Response json.RawMessage `json:"response"`
+	Error    *APIError       `json:"error,omitempty"`
+}
+
+func (vk *VkWebApi) ValidateUserAccessToken(ctx context.Context, userAccessToken string) (*entity.VkTokenInfo, error) {
+	cacheKey := tokenCacheKey(userAccessToken)
+
+	if tokenInfo, ok := vk.cachedToken(cacheKey); ok {
+		return &tokenInfo, nil
+	}
+
+	params := url.Values{}
+	params.Add("token", userAccessToken)
+
+	var tokenInfo entity.VkTokenInfo
+
+	err := vk.call(ctx, "secure.checkToken", params, &tokenInfo)
+	if err != nil {
+		return nil, tokenError(err)
+	}
+
+	if tokenInfo.Expire != 0 && time.Unix(int64(tokenInfo.Expire), 0).Before(time.Now()) {
+		return nil, entity.ErrVkTokenExpired
+	}
+
+	vk.cacheToken(cacheKey, tokenInfo)
+
+	return &tokenInfo, nil
+}
+
+type vkUser struct {
+	ID         int    `json:"id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Photo200   string `json:"photo_200"`
+	ScreenName string `json:"screen_name"`
+}
+
+// User - public profile of VK user by users.get.
+// It's optional data, so the call is made once, only while breaker is closed, and doesn't affect the breaker.
+func (vk *VkWebApi) User(ctx context.Context, vkID int) (*entity.VkUserInfo, error) {
+	if vk.breaker.State() != breaker.Closed {
+		return nil, &entity.VkUnavailableError{RetryAfter: vk.breaker.RetryAfter()}
+	}
+
+	params := url.Values{}
+	params.Add("user_ids", strconv.Itoa(vkID))
+	params.Add("fields", "photo_200,screen_name")
+
+	var users []vkUser
+
+	err := vk.doCall(ctx, "users.get", params, &users)
+	if err != nil {
+		vkCallErrors.WithLabelValues("users.get", errorCode(err)).Inc()
+
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, entity.ErrUserNotFound
+	}
+
+	return &entity.VkUserInfo{
+		VkID:       users[0].ID,
+		FirstName:  users[0].FirstName,
+		LastName:   users[0].LastName,
+		PhotoURL:   users[0].Photo200,
+		ScreenName: users[0].ScreenName,
+		UpdatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Ping - VK API reachability by utils.getServerTime, bypasses retries and circuit breaker.
+func (vk *VkWebApi) Ping(ctx context.Context) error {
+	var serverTime int64
+
+	return vk.doCall(ctx, "utils.getServerTime", url.Values{}, &serverTime)
+}
+
+// tokenCacheKey - tokens are kept in memory only as hashes.
+func tokenCacheKey(userAccessToken string) string {
+	sum := sha256.Sum256([]byte(userAccessToken))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (vk *VkWebApi) cachedToken(key string) (entity.VkTokenInfo, bool) {
+	if vk.tokenCache == nil {
+		return entity.VkTokenInfo{}, false
+	}
+
+	tokenInfo, ok := vk.tokenCache.Get(key)
+	// expired token is dropped by Get
+	vk.observeTokenCacheSize()
+
+	if !ok {
+		vkTokenCacheRequests.WithLabelValues("miss").Inc()
+
+		return entity.VkTokenInfo{}, false
+	}
+
+	vkTokenCacheRequests.WithLabelValues("hit").Inc()
+
+	return tokenInfo, true
+}
+
+func (vk *VkWebApi) cacheToken(key string, tokenInfo entity.VkTokenInfo) {
+	if vk.tokenCache == nil {
+		return
+	}
+
+	ttl := vk.tokenCacheTTL
+	if tokenInfo.Expire != 0 {
+		ttl = min(ttl, time.Until(time.Unix(int64(tokenInfo.Expire), 0)))
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	// Set may push out the oldest tokens
+	vk.tokenCache.Set(key, tokenInfo, ttl)
+	vk.observeTokenCacheSize()
+}
+
+func (vk *VkWebApi) observeTokenCacheSize() {
+	vkTokenCacheSize.Set(float64(vk.tokenCache.Len()))
+}
+
+// tokenError - maps secure.checkToken errors, VK reports both bad and expired tokens with "access denied" code.
+func tokenError(err error) error {
+	var apiErr *APIError
+	// VkUnavailableError wraps the last temporary error, it must reach the caller as is
+	if errors.Is(err, entity.ErrVkUnavailable) || !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.Code {
+	case _vkErrAccessDenied:
+		if strings.HasSuffix(apiErr.Msg, "session_expired") {
+			return entity.ErrVkTokenExpired
+		}
+
+		return entity.ErrBadVkToken
+	case _vkErrAuthFailed:
+		return fmt.Errorf("service key rejected: %w", apiErr)
+	default:
+		return apiErr
 	}
 }
 
-type validateUserAccessTokenResponse struct {
-	Response entity.VkTokenInfo `json:"response"`
-	Error    *struct {
-		ErrorCode int    `json:"error_code"`
-		ErrorMsg  string `json:"error_msg"`
-	} `json:"error,omitempty"`
+// call - invoke VK API method with service key, unmarshal "response" field into dst.
+// Transient failures are retried with jittered backoff, all attempts go through the circuit breaker.
+func (vk *VkWebApi) call(ctx context.Context, method string, params url.Values, dst any) error {
+	defer func(start time.Time) {
+		vkCallDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	var err error
+
+	for attempt := 0; attempt < vk.attempts; attempt++ {
+		if attempt > 0 {
+			vkCallRetries.WithLabelValues(method).Inc()
+
+			if err := sleep(ctx, backoff(vk.retryBackoff, attempt)); err != nil {
+				return err
+			}
+		}
+
+		var openErr *breaker.OpenError
+		if errors.As(vk.breaker.Allow(), &openErr) {
+			vkCallErrors.WithLabelValues(method, "breaker_open").Inc()
+
+			return &entity.VkUnavailableError{RetryAfter: openErr.RetryAfter}
+		}
+
+		err = vk.doCall(ctx, method, params, dst)
+		if err == nil {
+			vk.breaker.Success()
+
+			return nil
+		}
+
+		// Caller gave up, it says nothing about VK health
+		if ctx.Err() != nil {
+			vk.breaker.Release()
+			vkCallErrors.WithLabelValues(method, "canceled").Inc()
+
+			return err
+		}
+
+		vkCallErrors.WithLabelValues(method, errorCode(err)).Inc()
+
+		if !isTransient(ctx, err) {
+			vk.breaker.Success()
+
+			return err
+		}
+
+		vk.breaker.Failure()
+	}
+
+	return &entity.VkUnavailableError{
+		RetryAfter: max(vk.breaker.RetryAfter(), _maxRetryBackoff),
+		Err:        err,
+	}
 }
 
-func (vk *VkWebApi) ValidateUserAccessToken(userAccessToken string) (*entity.VkTokenInfo, error) {
-	u, _ := url.Parse("https://api.vk.com/method/secure.checkToken")
-	q := u.Query()
-	q.Add("v", "5.101")
-	q.Add("access_token", vk.ServiceSecret)
-	q.Add("token", userAccessToken)
+func (vk *VkWebApi) doCall(ctx context.Context, method string, params url.Values, dst any) error {
+	u, err := url.Parse(fmt.Sprintf("%s/method/%s", strings.TrimRight(vk.baseURL, "/"), method))
+	if err != nil {
+		return fmt.Errorf("can't parse url: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	q.Set("v", vk.version)
+	q.Set("access_token", vk.ServiceSecret)
 	u.RawQuery = q.Encode()
 
-	resp, err := http.Get(u.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("can't request to validate token: %w", err)
+		return fmt.Errorf("can't make request: %w", err)
+	}
+
+	resp, err := vk.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("can't request %s: %w", method, err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("can't read body: %w", err)
+		return fmt.Errorf("can't read body: %w", err)
 	}
 
-	var data validateUserAccessTokenResponse
+	if resp.StatusCode != http.StatusOK {
+		return &statusError{code: resp.StatusCode}
+	}
+
+	var data apiResponse
 	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, fmt.Errorf("can't unmarshal body: %w", err)
+		return fmt.Errorf("can't unmarshal body: %w", err)
 	}
 
 	if data.Error != nil {
-		switch data.Error.ErrorMsg {
-		case "Access denied: Incorrect token invalid_token":
-			return nil, entity.ErrBadVkToken
-		case "Access denied: Incorrect token session_expired":
-			return nil, entity.ErrVkTokenExpired
-		default:
-			return nil, fmt.Errorf("unknown error: %s", data.Error.ErrorMsg)
-		}
+		return data.Error
+	}
+
+	if err := json.Unmarshal(data.Response, dst); err != nil {
+		return fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected http status %d", e.code)
+}
+
+// isTransient - failure is worth retrying: network problems, VK 5xx/429 or temporary VK API error.
+func isTransient(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
 	}
 
-	return &data.Response, nil
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.code >= http.StatusInternalServerError || statusErr.code == http.StatusTooManyRequests
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func errorCode(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return strconv.Itoa(apiErr.Code)
+	}
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return "http_" + strconv.Itoa(statusErr.code)
+	}
+
+	return "transport"
+}
+
+// backoff - full jitter exponential backoff.
+func backoff(base time.Duration, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	if d <= 0 || d > _maxRetryBackoff {
+		d = _maxRetryBackoff
+	}
+
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,317 @@
+package webapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/VmesteApp/auth-service/internal/entity"
+	"github.com/VmesteApp/auth-service/internal/usecase/webapi"
+	"github.com/VmesteApp/auth-service/pkg/breaker"
+)
+
+const _checkTokenOK = `{"response":{"date":1700000000,"expire":0,"success":1,"user_id":494075}}`
+
+// fakeVk - VK API answering with failure to the first fails requests and with success after.
+type fakeVk struct {
+	fails   int
+	status  int
+	failure string
+	hits    atomic.Int32
+}
+
+func (f *fakeVk) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	if int(f.hits.Add(1)) > f.fails {
+		fmt.Fprint(w, _checkTokenOK)
+
+		return
+	}
+
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+
+		return
+	}
+
+	fmt.Fprint(w, f.failure)
+}
+
+func vkError(code int, msg string) string {
+	return fmt.Sprintf(`{"error":{"error_code":%d,"error_msg":%q}}`, code, msg)
+}
+
+func TestVkCallRetries(t *testing.T) {
+	t.Parallel()
+
+	const attempts = 3
+
+	tests := []struct {
+		name        string
+		vk          *fakeVk
+		threshold   int
+		hits        int32
+		err         error
+		unavailable bool
+	}{
+		{
+			name: "success",
+			vk:   &fakeVk{},
+			hits: 1,
+		},
+		{
+			name:        "unknown error is temporary",
+			vk:          &fakeVk{fails: attempts, failure: vkError(1, "Unknown error occurred")},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "too many requests is temporary",
+			vk:          &fakeVk{fails: attempts, failure: vkError(6, "Too many requests per second")},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "flood control is temporary",
+			vk:          &fakeVk{fails: attempts, failure: vkError(9, "Flood control")},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "internal error is temporary",
+			vk:          &fakeVk{fails: attempts, failure: vkError(10, "Internal server error")},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "rate limit is temporary",
+			vk:          &fakeVk{fails: attempts, failure: vkError(29, "Rate limit reached")},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "http 5xx is temporary",
+			vk:          &fakeVk{fails: attempts, status: http.StatusBadGateway},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name:        "http 429 is temporary",
+			vk:          &fakeVk{fails: attempts, status: http.StatusTooManyRequests},
+			hits:        attempts,
+			unavailable: true,
+		},
+		{
+			name: "success after temporary errors",
+			vk:   &fakeVk{fails: attempts - 1, failure: vkError(10, "Internal server error")},
+			hits: attempts,
+		},
+		{
+			name:        "open breaker stops retries",
+			vk:          &fakeVk{fails: attempts, failure: vkError(10, "Internal server error")},
+			hits:        2,
+			threshold:   2,
+			unavailable: true,
+		},
+		{
+			name: "access denied is permanent",
+			vk:   &fakeVk{fails: attempts, failure: vkError(15, "Access denied: invalid token")},
+			hits: 1,
+			err:  entity.ErrBadVkToken,
+		},
+		{
+			name: "expired token is permanent",
+			vk:   &fakeVk{fails: attempts, failure: vkError(15, "Access denied: session_expired")},
+			hits: 1,
+			err:  entity.ErrVkTokenExpired,
+		},
+		{
+			name: "rejected service key is permanent",
+			vk:   &fakeVk{fails: attempts, failure: vkError(5, "User authorization failed")},
+			hits: 1,
+			err:  &webapi.APIError{},
+		},
+		{
+			name: "http 4xx is permanent",
+			vk:   &fakeVk{fails: attempts, status: http.StatusBadRequest},
+			hits: 1,
+			err:  errors.New("unexpected http status 400"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(tc.vk)
+			defer srv.Close()
+
+			threshold := tc.threshold
+			if threshold == 0 {
+				threshold = 100
+			}
+
+			vk := webapi.New(1, "service-key",
+				webapi.BaseURL(srv.URL),
+				webapi.Retry(attempts, time.Millisecond),
+				webapi.CircuitBreaker(breaker.New(breaker.Threshold(threshold))),
+			)
+
+			_, err := vk.ValidateUserAccessToken(context.Background(), "token")
+
+			if got := tc.vk.hits.Load(); got != tc.hits {
+				t.Errorf("requests = %d, want %d", got, tc.hits)
+			}
+
+			var unavailableErr *entity.VkUnavailableError
+			if unavailable := errors.As(err, &unavailableErr); unavailable != tc.unavailable {
+				t.Fatalf("error = %v, unavailable %t, want %t", err, unavailable, tc.unavailable)
+			}
+
+			if tc.unavailable {
+				if !errors.Is(err, entity.ErrVkUnavailable) {
+					t.Errorf("error %v is not ErrVkUnavailable", err)
+				}
+				if unavailableErr.RetryAfter <= 0 {
+					t.Errorf("RetryAfter = %s, want positive", unavailableErr.RetryAfter)
+				}
+
+				return
+			}
+
+			var apiErr *webapi.APIError
+
+			switch want := tc.err.(type) {
+			case nil:
+				if err != nil {
+					t.Errorf("error = %v, want nil", err)
+				}
+			case *webapi.APIError:
+				if !errors.As(err, &apiErr) || apiErr.Temporary() {
+					t.Errorf("error = %v, want permanent APIError", err)
+				}
+			default:
+				if !errors.Is(err, want) && (err == nil || err.Error() != want.Error()) {
+					t.Errorf("error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestVkUnavailableErrorKeepsLastError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(&fakeVk{fails: 2, failure: vkError(29, "Rate limit reached")})
+	defer srv.Close()
+
+	vk := webapi.New(1, "service-key",
+		webapi.BaseURL(srv.URL),
+		webapi.Retry(2, time.Millisecond),
+		webapi.CircuitBreaker(breaker.New(breaker.Threshold(100))),
+	)
+
+	_, err := vk.ValidateUserAccessToken(context.Background(), "token")
+
+	var apiErr *webapi.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 29 {
+		t.Fatalf("error = %v, want wrapped vk api error 29", err)
+	}
+	if !apiErr.Temporary() {
+		t.Error("rate limit error isn't temporary")
+	}
+}
+
+func TestVkTokenCache(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cacheSize int
+		expire    time.Duration
+		tokens    []string
+		wait      time.Duration
+		hits      int32
+		err       error
+	}{
+		{
+			name:      "same token is checked once",
+			cacheSize: 10,
+			tokens:    []string{"a", "a", "a"},
+			hits:      1,
+		},
+		{
+			name:      "another token is checked",
+			cacheSize: 10,
+			tokens:    []string{"a", "b", "a"},
+			hits:      2,
+		},
+		{
+			name:      "oldest token is pushed out",
+			cacheSize: 1,
+			tokens:    []string{"a", "b", "a"},
+			hits:      3,
+		},
+		{
+			name:   "disabled cache",
+			tokens: []string{"a", "a"},
+			hits:   2,
+		},
+		{
+			name:      "token is cached not longer than it lives",
+			cacheSize: 10,
+			expire:    2 * time.Second,
+			tokens:    []string{"a", "a"},
+			wait:      3 * time.Second,
+			hits:      2,
+			err:       entity.ErrVkTokenExpired,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var expire int64
+			if tc.expire != 0 {
+				expire = time.Now().Add(tc.expire).Unix()
+			}
+
+			var hits atomic.Int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				hits.Add(1)
+				fmt.Fprintf(w, `{"response":{"date":1700000000,"expire":%d,"success":1,"user_id":494075}}`, expire)
+			}))
+			defer srv.Close()
+
+			vk := webapi.New(1, "service-key",
+				webapi.BaseURL(srv.URL),
+				webapi.TokenCache(tc.cacheSize, time.Hour),
+			)
+
+			var err error
+			for i, token := range tc.tokens {
+				if i == len(tc.tokens)-1 {
+					time.Sleep(tc.wait)
+				}
+
+				_, err = vk.ValidateUserAccessToken(context.Background(), token)
+				if err != nil && i < len(tc.tokens)-1 {
+					t.Fatalf("check %d: %s", i, err)
+				}
+			}
+
+			if !errors.Is(err, tc.err) {
+				t.Errorf("last check error = %v, want %v", err, tc.err)
+			}
+			if got := hits.Load(); got != tc.hits {
+				t.Errorf("requests = %d, want %d", got, tc.hits)
+			}
+		})
+	}
+}
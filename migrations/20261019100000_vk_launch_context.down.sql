@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vk_launch_contexts;
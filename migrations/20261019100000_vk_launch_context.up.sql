@@ -0,0 +1,18 @@
+CREATE TABLE
+  IF NOT EXISTS vk_launch_contexts (
+    id serial PRIMARY KEY,
+    user_id INT NOT NULL,
+    platform VARCHAR(64) NULL,
+    language VARCHAR(16) NULL,
+    is_app_user BOOLEAN NOT NULL DEFAULT FALSE,
+    are_notifications_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    is_favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ref VARCHAR(255) NULL,
+    group_id BIGINT NULL,
+    viewer_group_role VARCHAR(32) NULL,
+    chat_id VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+  );
+
+CREATE INDEX IF NOT EXISTS vk_launch_contexts_user_id_idx ON vk_launch_contexts (user_id, id DESC);
@@ -0,0 +1,11 @@
+CREATE TABLE
+  IF NOT EXISTS vk_profiles (
+    user_id INT PRIMARY KEY,
+    vk_id BIGINT NOT NULL,
+    first_name VARCHAR(255) NULL,
+    last_name VARCHAR(255) NULL,
+    photo_url TEXT NULL,
+    screen_name VARCHAR(255) NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+  );
@@ -0,0 +1,57 @@
+CREATE TABLE
+  IF NOT EXISTS roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    builtin BOOLEAN NOT NULL DEFAULT FALSE,
+    version BIGINT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+  );
+
+CREATE TABLE
+  IF NOT EXISTS permissions (
+    name VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+  );
+
+CREATE TABLE
+  IF NOT EXISTS role_permissions (
+    role VARCHAR(20) NOT NULL,
+    permission VARCHAR(64) NOT NULL,
+    PRIMARY KEY (role, permission),
+    FOREIGN KEY (role) REFERENCES roles (name) ON DELETE CASCADE,
+    FOREIGN KEY (permission) REFERENCES permissions (name) ON DELETE CASCADE
+  );
+
+INSERT INTO roles (name, description, builtin) VALUES
+  ('user', 'VK Mini App and social login users', TRUE),
+  ('admin', 'Support staff', TRUE),
+  ('superadmin', 'Manages admins and roles', TRUE),
+  ('service', 'Internal services calling gRPC API', TRUE)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO permissions (name, description) VALUES
+  ('users:read', 'Read users and their social logins'),
+  ('users:write', 'Change users'),
+  ('admins:read', 'List admins'),
+  ('admins:write', 'Create and delete admins'),
+  ('roles:read', 'List roles and permissions'),
+  ('roles:write', 'Create, change and delete custom roles'),
+  ('profiles:read', 'Read VK profiles')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+  SELECT 'superadmin', name FROM permissions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'users:read'),
+  ('admin', 'profiles:read'),
+  ('service', 'users:read'),
+  ('service', 'profiles:read'),
+  ('user', 'profiles:read')
+ON CONFLICT DO NOTHING;
+
+UPDATE users SET role = 'user' WHERE role IS NULL OR role NOT IN (SELECT name FROM roles);
+
+ALTER TABLE users
+ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles (name) ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+ALTER TABLE users
+ADD COLUMN token_version BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS social_logins_provider_idx;
+DROP INDEX IF EXISTS users_email_pattern_idx;
+DROP INDEX IF EXISTS users_created_at_id_idx;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,6 @@
+ALTER TABLE users
+ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS users_created_at_id_idx ON users (created_at, id);
+CREATE INDEX IF NOT EXISTS users_email_pattern_idx ON users (email text_pattern_ops);
+CREATE INDEX IF NOT EXISTS social_logins_provider_idx ON social_logins (provider, provider_id);
@@ -0,0 +1,14 @@
+DELETE FROM role_permissions WHERE permission = 'users:moderate';
+DELETE FROM permissions WHERE name = 'users:moderate';
+
+UPDATE roles SET version = version + 1 WHERE name IN ('admin', 'superadmin');
+
+ALTER TABLE users
+DROP CONSTRAINT IF EXISTS users_status_until_check,
+DROP CONSTRAINT IF EXISTS users_status_check;
+
+UPDATE users SET status = 'disabled' WHERE status IN ('suspended', 'banned');
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS status_reason,
+DROP COLUMN IF EXISTS status_until;
@@ -0,0 +1,20 @@
+ALTER TABLE users
+ADD COLUMN status_until TIMESTAMP NULL,
+ADD COLUMN status_reason TEXT NULL;
+
+UPDATE users SET status = 'banned' WHERE status = 'disabled';
+
+ALTER TABLE users
+ADD CONSTRAINT users_status_check CHECK (status IN ('active', 'suspended', 'banned')),
+ADD CONSTRAINT users_status_until_check CHECK (status <> 'suspended' OR status_until IS NOT NULL);
+
+INSERT INTO permissions (name, description) VALUES
+  ('users:moderate', 'Suspend, ban and reactivate users')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'users:moderate'),
+  ('superadmin', 'users:moderate')
+ON CONFLICT DO NOTHING;
+
+UPDATE roles SET version = version + 1 WHERE name IN ('admin', 'superadmin');
@@ -0,0 +1,10 @@
+-- Удалённые пользователи не удаляются безвозвратно: их нужно восстановить или удалить вручную
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM users WHERE deleted_at IS NOT NULL) THEN
+    RAISE EXCEPTION 'users has soft deleted rows, restore or delete them before rollback';
+  END IF;
+END $$;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS deleted_at;
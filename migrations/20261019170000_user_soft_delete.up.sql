@@ -0,0 +1,2 @@
+ALTER TABLE users
+ADD COLUMN deleted_at TIMESTAMP NULL;
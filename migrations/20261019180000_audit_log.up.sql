@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT,
+    target_id BIGINT,
+    action VARCHAR(64) NOT NULL,
+    ip VARCHAR(64) NULL,
+    user_agent TEXT NULL,
+    outcome VARCHAR(16) NOT NULL DEFAULT 'success',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    prev_hash VARCHAR(64) NULL,
+    hash VARCHAR(64) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_target_id_idx ON audit_events (target_id);
+CREATE INDEX IF NOT EXISTS audit_events_actor_id_idx ON audit_events (actor_id);
+CREATE INDEX IF NOT EXISTS audit_events_action_idx ON audit_events (action);
+CREATE INDEX IF NOT EXISTS audit_events_created_at_idx ON audit_events (created_at);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_no_update_delete
+  BEFORE UPDATE OR DELETE ON audit_events
+  FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER audit_events_no_truncate
+  BEFORE TRUNCATE ON audit_events
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
+
+INSERT INTO permissions (name, description) VALUES
+  ('audit:read', 'Query and verify audit log')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('superadmin', 'audit:read')
+ON CONFLICT DO NOTHING;
+
+UPDATE roles SET version = version + 1 WHERE name = 'superadmin';
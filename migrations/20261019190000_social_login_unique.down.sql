@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS social_logins_provider_uidx;
+
+CREATE INDEX IF NOT EXISTS social_logins_provider_idx ON social_logins (provider, provider_id);
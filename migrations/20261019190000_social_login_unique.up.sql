@@ -0,0 +1,23 @@
+-- Дубликаты могли появиться при повторной регистрации. Какую привязку оставить, решает оператор вручную,
+-- поэтому миграция падает со списком дубликатов вместо их удаления
+DO $$
+DECLARE
+  duplicates TEXT;
+BEGIN
+  SELECT string_agg(format('%s/%s (ids %s)', provider, provider_id, ids), ', ')
+    INTO duplicates
+    FROM (
+      SELECT provider, provider_id, string_agg(id::TEXT, ',' ORDER BY id) AS ids
+        FROM social_logins
+        GROUP BY provider, provider_id
+        HAVING COUNT(*) > 1
+    ) d;
+
+  IF duplicates IS NOT NULL THEN
+    RAISE EXCEPTION 'social_logins has duplicate provider logins, merge or delete them before migration: %', duplicates;
+  END IF;
+END $$;
+
+DROP INDEX IF EXISTS social_logins_provider_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS social_logins_provider_uidx ON social_logins (provider, provider_id);
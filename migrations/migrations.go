@@ -0,0 +1,36 @@
+// Package migrations embeds SQL migrations into the binary.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// Version - the latest embedded migration version, the one database has after migrate up.
+func Version() (uint, error) {
+	files, err := fs.Glob(FS, "*.up.sql")
+	if err != nil {
+		return 0, fmt.Errorf("can't list migrations: %w", err)
+	}
+
+	var latest uint64
+
+	for _, file := range files {
+		prefix, _, _ := strings.Cut(file, "_")
+
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("can't parse version of migration %s: %w", file, err)
+		}
+
+		latest = max(latest, version)
+	}
+
+	return uint(latest), nil
+}
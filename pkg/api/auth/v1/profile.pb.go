@@ -0,0 +1,811 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: auth/v1/profile.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetVkIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetVkIDRequest) Reset() {
+	*x = GetVkIDRequest{}
+	mi := &file_auth_v1_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkIDRequest) ProtoMessage() {}
+
+func (x *GetVkIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkIDRequest.ProtoReflect.Descriptor instead.
+func (*GetVkIDRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetVkIDRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GetVkIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VkID int64 `protobuf:"varint,1,opt,name=vkID,proto3" json:"vkID,omitempty"`
+}
+
+func (x *GetVkIDResponse) Reset() {
+	*x = GetVkIDResponse{}
+	mi := &file_auth_v1_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkIDResponse) ProtoMessage() {}
+
+func (x *GetVkIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVkIDResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVkIDResponse) GetVkID() int64 {
+	if x != nil {
+		return x.VkID
+	}
+	return 0
+}
+
+type GetVkIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []int64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+}
+
+func (x *GetVkIDsRequest) Reset() {
+	*x = GetVkIDsRequest{}
+	mi := &file_auth_v1_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkIDsRequest) ProtoMessage() {}
+
+func (x *GetVkIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetVkIDsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVkIDsRequest) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetVkIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// userID -> vkID
+	VkIDs    map[int64]int64 `protobuf:"bytes,1,rep,name=vkIDs,proto3" json:"vkIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NotFound []int64         `protobuf:"varint,2,rep,packed,name=notFound,proto3" json:"notFound,omitempty"`
+}
+
+func (x *GetVkIDsResponse) Reset() {
+	*x = GetVkIDsResponse{}
+	mi := &file_auth_v1_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkIDsResponse) ProtoMessage() {}
+
+func (x *GetVkIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetVkIDsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetVkIDsResponse) GetVkIDs() map[int64]int64 {
+	if x != nil {
+		return x.VkIDs
+	}
+	return nil
+}
+
+func (x *GetVkIDsResponse) GetNotFound() []int64 {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type GetUserIDsByVkIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VkIDs []int64 `protobuf:"varint,1,rep,packed,name=vkIDs,proto3" json:"vkIDs,omitempty"`
+}
+
+func (x *GetUserIDsByVkIDsRequest) Reset() {
+	*x = GetUserIDsByVkIDsRequest{}
+	mi := &file_auth_v1_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserIDsByVkIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserIDsByVkIDsRequest) ProtoMessage() {}
+
+func (x *GetUserIDsByVkIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserIDsByVkIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserIDsByVkIDsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserIDsByVkIDsRequest) GetVkIDs() []int64 {
+	if x != nil {
+		return x.VkIDs
+	}
+	return nil
+}
+
+type GetUserIDsByVkIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vkID -> userID
+	UserIDs  map[int64]int64 `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NotFound []int64         `protobuf:"varint,2,rep,packed,name=notFound,proto3" json:"notFound,omitempty"`
+}
+
+func (x *GetUserIDsByVkIDsResponse) Reset() {
+	*x = GetUserIDsByVkIDsResponse{}
+	mi := &file_auth_v1_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserIDsByVkIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserIDsByVkIDsResponse) ProtoMessage() {}
+
+func (x *GetUserIDsByVkIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserIDsByVkIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserIDsByVkIDsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUserIDsByVkIDsResponse) GetUserIDs() map[int64]int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *GetUserIDsByVkIDsResponse) GetNotFound() []int64 {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type GetVkProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetVkProfileRequest) Reset() {
+	*x = GetVkProfileRequest{}
+	mi := &file_auth_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkProfileRequest) ProtoMessage() {}
+
+func (x *GetVkProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetVkProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetVkProfileRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GetVkProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VkID   int64 `protobuf:"varint,2,opt,name=vkID,proto3" json:"vkID,omitempty"`
+	// Latest VK Mini App launch context, absent when user never launched the mini app.
+	LaunchContext *VkLaunchContext `protobuf:"bytes,3,opt,name=launchContext,proto3" json:"launchContext,omitempty"`
+	// Public VK profile, absent until it's fetched on login.
+	Info *VkUserInfo `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *GetVkProfileResponse) Reset() {
+	*x = GetVkProfileResponse{}
+	mi := &file_auth_v1_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVkProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVkProfileResponse) ProtoMessage() {}
+
+func (x *GetVkProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVkProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetVkProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetVkProfileResponse) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetVkProfileResponse) GetVkID() int64 {
+	if x != nil {
+		return x.VkID
+	}
+	return 0
+}
+
+func (x *GetVkProfileResponse) GetLaunchContext() *VkLaunchContext {
+	if x != nil {
+		return x.LaunchContext
+	}
+	return nil
+}
+
+func (x *GetVkProfileResponse) GetInfo() *VkUserInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type VkUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName  string                 `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName   string                 `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	PhotoURL   string                 `protobuf:"bytes,3,opt,name=photoURL,proto3" json:"photoURL,omitempty"`
+	ScreenName string                 `protobuf:"bytes,4,opt,name=screenName,proto3" json:"screenName,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *VkUserInfo) Reset() {
+	*x = VkUserInfo{}
+	mi := &file_auth_v1_profile_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VkUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VkUserInfo) ProtoMessage() {}
+
+func (x *VkUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VkUserInfo.ProtoReflect.Descriptor instead.
+func (*VkUserInfo) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VkUserInfo) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *VkUserInfo) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *VkUserInfo) GetPhotoURL() string {
+	if x != nil {
+		return x.PhotoURL
+	}
+	return ""
+}
+
+func (x *VkUserInfo) GetScreenName() string {
+	if x != nil {
+		return x.ScreenName
+	}
+	return ""
+}
+
+func (x *VkUserInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type VkLaunchContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform                string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Language                string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	IsAppUser               bool                   `protobuf:"varint,3,opt,name=isAppUser,proto3" json:"isAppUser,omitempty"`
+	AreNotificationsEnabled bool                   `protobuf:"varint,4,opt,name=areNotificationsEnabled,proto3" json:"areNotificationsEnabled,omitempty"`
+	IsFavorite              bool                   `protobuf:"varint,5,opt,name=isFavorite,proto3" json:"isFavorite,omitempty"`
+	Ref                     string                 `protobuf:"bytes,6,opt,name=ref,proto3" json:"ref,omitempty"`
+	GroupID                 int64                  `protobuf:"varint,7,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	ViewerGroupRole         string                 `protobuf:"bytes,8,opt,name=viewerGroupRole,proto3" json:"viewerGroupRole,omitempty"`
+	ChatID                  string                 `protobuf:"bytes,9,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	LaunchedAt              *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=launchedAt,proto3" json:"launchedAt,omitempty"`
+}
+
+func (x *VkLaunchContext) Reset() {
+	*x = VkLaunchContext{}
+	mi := &file_auth_v1_profile_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VkLaunchContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VkLaunchContext) ProtoMessage() {}
+
+func (x *VkLaunchContext) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VkLaunchContext.ProtoReflect.Descriptor instead.
+func (*VkLaunchContext) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VkLaunchContext) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *VkLaunchContext) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *VkLaunchContext) GetIsAppUser() bool {
+	if x != nil {
+		return x.IsAppUser
+	}
+	return false
+}
+
+func (x *VkLaunchContext) GetAreNotificationsEnabled() bool {
+	if x != nil {
+		return x.AreNotificationsEnabled
+	}
+	return false
+}
+
+func (x *VkLaunchContext) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *VkLaunchContext) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *VkLaunchContext) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *VkLaunchContext) GetViewerGroupRole() string {
+	if x != nil {
+		return x.ViewerGroupRole
+	}
+	return ""
+}
+
+func (x *VkLaunchContext) GetChatID() string {
+	if x != nil {
+		return x.ChatID
+	}
+	return ""
+}
+
+func (x *VkLaunchContext) GetLaunchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LaunchedAt
+	}
+	return nil
+}
+
+var File_auth_v1_profile_proto protoreflect.FileDescriptor
+
+var file_auth_v1_profile_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x6b,
+	0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0xa4, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56,
+	0x6b, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x6b, 0x49, 0x44, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x38, 0x0a, 0x0a,
+	0x56, 0x6b, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x6b, 0x49, 0x44, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x42, 0x79, 0x56, 0x6b,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x56, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x56, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6b, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x6b, 0x49, 0x44, 0x12, 0x3e, 0x0a,
+	0x0d, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6b, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d,
+	0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x56, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x02, 0x0a, 0x0f, 0x56, 0x6b, 0x4c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x17, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x17, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
+	0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x32, 0xc0, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49,
+	0x44, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44,
+	0x73, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x12, 0x21, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x41, 0x70, 0x70, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_auth_v1_profile_proto_rawDescOnce sync.Once
+	file_auth_v1_profile_proto_rawDescData = file_auth_v1_profile_proto_rawDesc
+)
+
+func file_auth_v1_profile_proto_rawDescGZIP() []byte {
+	file_auth_v1_profile_proto_rawDescOnce.Do(func() {
+		file_auth_v1_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_auth_v1_profile_proto_rawDescData)
+	})
+	return file_auth_v1_profile_proto_rawDescData
+}
+
+var file_auth_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_auth_v1_profile_proto_goTypes = []any{
+	(*GetVkIDRequest)(nil),            // 0: auth.v1.GetVkIDRequest
+	(*GetVkIDResponse)(nil),           // 1: auth.v1.GetVkIDResponse
+	(*GetVkIDsRequest)(nil),           // 2: auth.v1.GetVkIDsRequest
+	(*GetVkIDsResponse)(nil),          // 3: auth.v1.GetVkIDsResponse
+	(*GetUserIDsByVkIDsRequest)(nil),  // 4: auth.v1.GetUserIDsByVkIDsRequest
+	(*GetUserIDsByVkIDsResponse)(nil), // 5: auth.v1.GetUserIDsByVkIDsResponse
+	(*GetVkProfileRequest)(nil),       // 6: auth.v1.GetVkProfileRequest
+	(*GetVkProfileResponse)(nil),      // 7: auth.v1.GetVkProfileResponse
+	(*VkUserInfo)(nil),                // 8: auth.v1.VkUserInfo
+	(*VkLaunchContext)(nil),           // 9: auth.v1.VkLaunchContext
+	nil,                               // 10: auth.v1.GetVkIDsResponse.VkIDsEntry
+	nil,                               // 11: auth.v1.GetUserIDsByVkIDsResponse.UserIDsEntry
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+}
+var file_auth_v1_profile_proto_depIdxs = []int32{
+	10, // 0: auth.v1.GetVkIDsResponse.vkIDs:type_name -> auth.v1.GetVkIDsResponse.VkIDsEntry
+	11, // 1: auth.v1.GetUserIDsByVkIDsResponse.userIDs:type_name -> auth.v1.GetUserIDsByVkIDsResponse.UserIDsEntry
+	9,  // 2: auth.v1.GetVkProfileResponse.launchContext:type_name -> auth.v1.VkLaunchContext
+	8,  // 3: auth.v1.GetVkProfileResponse.info:type_name -> auth.v1.VkUserInfo
+	12, // 4: auth.v1.VkUserInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	12, // 5: auth.v1.VkLaunchContext.launchedAt:type_name -> google.protobuf.Timestamp
+	0,  // 6: auth.v1.ProfileService.GetVkID:input_type -> auth.v1.GetVkIDRequest
+	6,  // 7: auth.v1.ProfileService.GetVkProfile:input_type -> auth.v1.GetVkProfileRequest
+	2,  // 8: auth.v1.ProfileService.GetVkIDs:input_type -> auth.v1.GetVkIDsRequest
+	4,  // 9: auth.v1.ProfileService.GetUserIDsByVkIDs:input_type -> auth.v1.GetUserIDsByVkIDsRequest
+	1,  // 10: auth.v1.ProfileService.GetVkID:output_type -> auth.v1.GetVkIDResponse
+	7,  // 11: auth.v1.ProfileService.GetVkProfile:output_type -> auth.v1.GetVkProfileResponse
+	3,  // 12: auth.v1.ProfileService.GetVkIDs:output_type -> auth.v1.GetVkIDsResponse
+	5,  // 13: auth.v1.ProfileService.GetUserIDsByVkIDs:output_type -> auth.v1.GetUserIDsByVkIDsResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_profile_proto_init() }
+func file_auth_v1_profile_proto_init() {
+	if File_auth_v1_profile_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_auth_v1_profile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_profile_proto_goTypes,
+		DependencyIndexes: file_auth_v1_profile_proto_depIdxs,
+		MessageInfos:      file_auth_v1_profile_proto_msgTypes,
+	}.Build()
+	File_auth_v1_profile_proto = out.File
+	file_auth_v1_profile_proto_rawDesc = nil
+	file_auth_v1_profile_proto_goTypes = nil
+	file_auth_v1_profile_proto_depIdxs = nil
+}
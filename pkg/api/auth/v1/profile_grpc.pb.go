@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: auth/v1/profile.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProfileService_GetVkID_FullMethodName           = "/auth.v1.ProfileService/GetVkID"
+	ProfileService_GetVkProfile_FullMethodName      = "/auth.v1.ProfileService/GetVkProfile"
+	ProfileService_GetVkIDs_FullMethodName          = "/auth.v1.ProfileService/GetVkIDs"
+	ProfileService_GetUserIDsByVkIDs_FullMethodName = "/auth.v1.ProfileService/GetUserIDsByVkIDs"
+)
+
+// ProfileServiceClient is the client API for ProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProfileServiceClient interface {
+	GetVkID(ctx context.Context, in *GetVkIDRequest, opts ...grpc.CallOption) (*GetVkIDResponse, error)
+	GetVkProfile(ctx context.Context, in *GetVkProfileRequest, opts ...grpc.CallOption) (*GetVkProfileResponse, error)
+	// Batch lookups, IDs which are not found are listed in notFound instead of failing the call.
+	GetVkIDs(ctx context.Context, in *GetVkIDsRequest, opts ...grpc.CallOption) (*GetVkIDsResponse, error)
+	GetUserIDsByVkIDs(ctx context.Context, in *GetUserIDsByVkIDsRequest, opts ...grpc.CallOption) (*GetUserIDsByVkIDsResponse, error)
+}
+
+type profileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileServiceClient(cc grpc.ClientConnInterface) ProfileServiceClient {
+	return &profileServiceClient{cc}
+}
+
+func (c *profileServiceClient) GetVkID(ctx context.Context, in *GetVkIDRequest, opts ...grpc.CallOption) (*GetVkIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVkIDResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetVkID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetVkProfile(ctx context.Context, in *GetVkProfileRequest, opts ...grpc.CallOption) (*GetVkProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVkProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetVkProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetVkIDs(ctx context.Context, in *GetVkIDsRequest, opts ...grpc.CallOption) (*GetVkIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVkIDsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetVkIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetUserIDsByVkIDs(ctx context.Context, in *GetUserIDsByVkIDsRequest, opts ...grpc.CallOption) (*GetUserIDsByVkIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserIDsByVkIDsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetUserIDsByVkIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServiceServer is the server API for ProfileService service.
+// All implementations must embed UnimplementedProfileServiceServer
+// for forward compatibility.
+type ProfileServiceServer interface {
+	GetVkID(context.Context, *GetVkIDRequest) (*GetVkIDResponse, error)
+	GetVkProfile(context.Context, *GetVkProfileRequest) (*GetVkProfileResponse, error)
+	// Batch lookups, IDs which are not found are listed in notFound instead of failing the call.
+	GetVkIDs(context.Context, *GetVkIDsRequest) (*GetVkIDsResponse, error)
+	GetUserIDsByVkIDs(context.Context, *GetUserIDsByVkIDsRequest) (*GetUserIDsByVkIDsResponse, error)
+	mustEmbedUnimplementedProfileServiceServer()
+}
+
+// UnimplementedProfileServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProfileServiceServer struct{}
+
+func (UnimplementedProfileServiceServer) GetVkID(context.Context, *GetVkIDRequest) (*GetVkIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVkID not implemented")
+}
+func (UnimplementedProfileServiceServer) GetVkProfile(context.Context, *GetVkProfileRequest) (*GetVkProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVkProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) GetVkIDs(context.Context, *GetVkIDsRequest) (*GetVkIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVkIDs not implemented")
+}
+func (UnimplementedProfileServiceServer) GetUserIDsByVkIDs(context.Context, *GetUserIDsByVkIDsRequest) (*GetUserIDsByVkIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserIDsByVkIDs not implemented")
+}
+func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
+func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProfileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServiceServer will
+// result in compilation errors.
+type UnsafeProfileServiceServer interface {
+	mustEmbedUnimplementedProfileServiceServer()
+}
+
+func RegisterProfileServiceServer(s grpc.ServiceRegistrar, srv ProfileServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProfileServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProfileService_ServiceDesc, srv)
+}
+
+func _ProfileService_GetVkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVkIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetVkID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetVkID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetVkID(ctx, req.(*GetVkIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetVkProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVkProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetVkProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetVkProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetVkProfile(ctx, req.(*GetVkProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetVkIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVkIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetVkIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetVkIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetVkIDs(ctx, req.(*GetVkIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetUserIDsByVkIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIDsByVkIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetUserIDsByVkIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetUserIDsByVkIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetUserIDsByVkIDs(ctx, req.(*GetUserIDsByVkIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.ProfileService",
+	HandlerType: (*ProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVkID",
+			Handler:    _ProfileService_GetVkID_Handler,
+		},
+		{
+			MethodName: "GetVkProfile",
+			Handler:    _ProfileService_GetVkProfile_Handler,
+		},
+		{
+			MethodName: "GetVkIDs",
+			Handler:    _ProfileService_GetVkIDs_Handler,
+		},
+		{
+			MethodName: "GetUserIDsByVkIDs",
+			Handler:    _ProfileService_GetUserIDsByVkIDs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/profile.proto",
+}
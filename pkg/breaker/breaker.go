@@ -0,0 +1,173 @@
+// Package breaker implements circuit breaker.
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	_defaultThreshold   = 5
+	_defaultOpenTimeout = 30 * time.Second
+)
+
+// State -.
+type State int
+
+const (
+	Closed State = iota
+	HalfOpen
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrOpen -.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError - returned while breaker is open, RetryAfter is the time left until the next probe.
+type OpenError struct {
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	return ErrOpen.Error()
+}
+
+func (e *OpenError) Is(target error) bool {
+	return target == ErrOpen
+}
+
+// Breaker opens after threshold consecutive failures, after openTimeout lets one probe call through.
+type Breaker struct {
+	mu          sync.Mutex
+	state       State
+	failures    int
+	openedAt    time.Time
+	probing     bool
+	threshold   int
+	openTimeout time.Duration
+
+	onStateChange func(State)
+}
+
+// New -.
+func New(opts ...Option) *Breaker {
+	b := &Breaker{
+		threshold:   _defaultThreshold,
+		openTimeout: _defaultOpenTimeout,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// State -.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// Allow - reports whether call may be done, returns *OpenError otherwise.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		left := b.openTimeout - time.Since(b.openedAt)
+		if left > 0 {
+			return &OpenError{RetryAfter: left}
+		}
+
+		b.setState(HalfOpen)
+		b.probing = true
+
+		return nil
+	case HalfOpen:
+		if b.probing {
+			return &OpenError{RetryAfter: b.openTimeout}
+		}
+
+		b.probing = true
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// RetryAfter - time left until the next probe while breaker is open, zero otherwise.
+func (b *Breaker) RetryAfter() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != Open {
+		return 0
+	}
+
+	return max(b.openTimeout-time.Since(b.openedAt), 0)
+}
+
+// Success - report successful call.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+	b.setState(Closed)
+}
+
+// Failure - report failed call.
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.state == HalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		b.setState(Open)
+	}
+}
+
+// Release - report call finished without outcome, e.g. canceled by caller.
+// Allowed probe is given back, state and failures are kept.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+
+	b.state = state
+
+	if b.onStateChange != nil {
+		b.onStateChange(state)
+	}
+}
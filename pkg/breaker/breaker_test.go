@@ -0,0 +1,151 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type step int
+
+const (
+	success step = iota
+	failure
+	release
+	expire
+)
+
+func TestBreakerTransitions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		steps     []step
+		state     State
+		allowed   bool
+		stateLogs []State
+	}{
+		{
+			name:    "closed below threshold",
+			steps:   []step{failure, failure},
+			state:   Closed,
+			allowed: true,
+		},
+		{
+			name:    "success resets failures",
+			steps:   []step{failure, failure, success, failure, failure},
+			state:   Closed,
+			allowed: true,
+		},
+		{
+			name:      "opens on threshold",
+			steps:     []step{failure, failure, failure},
+			state:     Open,
+			allowed:   false,
+			stateLogs: []State{Open},
+		},
+		{
+			name:      "half-open after timeout lets one probe",
+			steps:     []step{failure, failure, failure, expire},
+			state:     HalfOpen,
+			allowed:   false,
+			stateLogs: []State{Open, HalfOpen},
+		},
+		{
+			name:      "probe success closes",
+			steps:     []step{failure, failure, failure, expire, success},
+			state:     Closed,
+			allowed:   true,
+			stateLogs: []State{Open, HalfOpen, Closed},
+		},
+		{
+			name:      "probe failure opens again",
+			steps:     []step{failure, failure, failure, expire, failure},
+			state:     Open,
+			allowed:   false,
+			stateLogs: []State{Open, HalfOpen, Open},
+		},
+		{
+			name:      "released probe may be retried",
+			steps:     []step{failure, failure, failure, expire, release},
+			state:     HalfOpen,
+			allowed:   true,
+			stateLogs: []State{Open, HalfOpen},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stateLogs []State
+
+			b := New(Threshold(3), OpenTimeout(time.Minute), OnStateChange(func(s State) {
+				stateLogs = append(stateLogs, s)
+			}))
+
+			for _, s := range tc.steps {
+				switch s {
+				case success:
+					b.Success()
+				case failure:
+					b.Failure()
+				case release:
+					b.Release()
+				case expire:
+					b.mu.Lock()
+					b.openedAt = b.openedAt.Add(-b.openTimeout)
+					b.mu.Unlock()
+
+					if err := b.Allow(); err != nil {
+						t.Fatalf("probe after open timeout is denied: %s", err)
+					}
+				}
+			}
+
+			if got := b.State(); got != tc.state {
+				t.Errorf("state = %s, want %s", got, tc.state)
+			}
+
+			err := b.Allow()
+			if allowed := err == nil; allowed != tc.allowed {
+				t.Errorf("allowed = %t, want %t (err %v)", allowed, tc.allowed, err)
+			}
+			if err != nil && !errors.Is(err, ErrOpen) {
+				t.Errorf("error %v is not ErrOpen", err)
+			}
+
+			if len(stateLogs) != len(tc.stateLogs) {
+				t.Fatalf("state changes = %v, want %v", stateLogs, tc.stateLogs)
+			}
+			for i := range stateLogs {
+				if stateLogs[i] != tc.stateLogs[i] {
+					t.Fatalf("state changes = %v, want %v", stateLogs, tc.stateLogs)
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	b := New(Threshold(1), OpenTimeout(time.Minute))
+
+	if got := b.RetryAfter(); got != 0 {
+		t.Errorf("closed RetryAfter = %s, want 0", got)
+	}
+
+	b.Failure()
+
+	var openErr *OpenError
+	if !errors.As(b.Allow(), &openErr) {
+		t.Fatal("open breaker allows calls")
+	}
+	if openErr.RetryAfter <= 0 || openErr.RetryAfter > time.Minute {
+		t.Errorf("OpenError.RetryAfter = %s, want (0, 1m]", openErr.RetryAfter)
+	}
+	if got := b.RetryAfter(); got <= 0 || got > time.Minute {
+		t.Errorf("RetryAfter = %s, want (0, 1m]", got)
+	}
+}
@@ -0,0 +1,27 @@
+package breaker
+
+import "time"
+
+// Option -.
+type Option func(*Breaker)
+
+// Threshold -.
+func Threshold(failures int) Option {
+	return func(b *Breaker) {
+		b.threshold = failures
+	}
+}
+
+// OpenTimeout -.
+func OpenTimeout(timeout time.Duration) Option {
+	return func(b *Breaker) {
+		b.openTimeout = timeout
+	}
+}
+
+// OnStateChange -.
+func OnStateChange(fn func(State)) Option {
+	return func(b *Breaker) {
+		b.onStateChange = fn
+	}
+}
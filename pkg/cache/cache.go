@@ -0,0 +1,173 @@
+// Package cache implements in-memory LRU cache with per item TTL.
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrFull - all items are alive and none of them may be pushed out.
+var ErrFull = errors.New("cache is full")
+
+type item[K comparable, V any] struct {
+	key      K
+	value    V
+	expireAt time.Time
+}
+
+// Cache -.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	maxSize int
+	items   map[K]*list.Element
+	order   *list.List
+	onEvict func(key K, value V)
+}
+
+// New - make cache limited by maxSize items, onEvict (may be nil) is called when item is pushed out by size limit.
+func New[K comparable, V any](maxSize int, onEvict func(key K, value V)) *Cache[K, V] {
+	return &Cache[K, V]{
+		maxSize: maxSize,
+		items:   make(map[K]*list.Element),
+		order:   list.New(),
+		onEvict: onEvict,
+	}
+}
+
+// Get -.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	el, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	it := el.Value.(*item[K, V])
+	if time.Now().After(it.expireAt) {
+		c.remove(el)
+
+		return zero, false
+	}
+
+	c.order.MoveToFront(el)
+
+	return it.value, true
+}
+
+// Set - put value for ttl, replacing existing one.
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+
+	c.add(key, value, ttl)
+}
+
+// Add - put value for ttl only if key is absent or expired, reports whether value was added.
+func (c *Cache[K, V]) Add(key K, value V, ttl time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		if time.Now().Before(el.Value.(*item[K, V]).expireAt) {
+			return false
+		}
+
+		c.remove(el)
+	}
+
+	c.add(key, value, ttl)
+
+	return true
+}
+
+// AddLive - like Add, but never pushes out unexpired items: expired ones are dropped to make room
+// and ErrFull is returned when the cache is full of alive items.
+func (c *Cache[K, V]) AddLive(key K, value V, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	if el, ok := c.items[key]; ok {
+		if now.Before(el.Value.(*item[K, V]).expireAt) {
+			return false, nil
+		}
+
+		c.remove(el)
+	}
+
+	if c.order.Len() >= c.maxSize {
+		c.removeExpired(now)
+	}
+	if c.order.Len() >= c.maxSize {
+		return false, ErrFull
+	}
+
+	c.add(key, value, ttl)
+
+	return true, nil
+}
+
+// Delete -.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Len -.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache[K, V]) add(key K, value V, ttl time.Duration) {
+	if c.maxSize <= 0 {
+		return
+	}
+
+	for c.order.Len() >= c.maxSize {
+		oldest := c.order.Back()
+		it := oldest.Value.(*item[K, V])
+
+		c.remove(oldest)
+
+		if c.onEvict != nil && time.Now().Before(it.expireAt) {
+			c.onEvict(it.key, it.value)
+		}
+	}
+
+	c.items[key] = c.order.PushFront(&item[K, V]{key: key, value: value, expireAt: time.Now().Add(ttl)})
+}
+
+func (c *Cache[K, V]) removeExpired(now time.Time) {
+	for el := c.order.Back(); el != nil; {
+		prev := el.Prev()
+
+		if !now.Before(el.Value.(*item[K, V]).expireAt) {
+			c.remove(el)
+		}
+
+		el = prev
+	}
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*item[K, V]).key)
+}
@@ -0,0 +1,291 @@
+package cache_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/VmesteApp/auth-service/pkg/cache"
+)
+
+const (
+	_short = 20 * time.Millisecond
+	_long  = time.Hour
+)
+
+func TestCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ttl   time.Duration
+		wait  time.Duration
+		found bool
+	}{
+		{
+			name:  "alive",
+			ttl:   _long,
+			found: true,
+		},
+		{
+			name: "expired",
+			ttl:  _short,
+			wait: 2 * _short,
+		},
+		{
+			name: "zero ttl",
+		},
+		{
+			name: "negative ttl",
+			ttl:  -_long,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := cache.New[string, int](10, nil)
+			c.Set("a", 1, tc.ttl)
+
+			time.Sleep(tc.wait)
+
+			v, ok := c.Get("a")
+			if ok != tc.found {
+				t.Fatalf("Get found = %t, want %t", ok, tc.found)
+			}
+			if ok && v != 1 {
+				t.Errorf("Get = %d, want 1", v)
+			}
+
+			// expired item is dropped by Get
+			wantLen := 0
+			if tc.found {
+				wantLen = 1
+			}
+			if c.Len() != wantLen {
+				t.Errorf("Len = %d, want %d", c.Len(), wantLen)
+			}
+		})
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		fill    func(c *cache.Cache[string, int])
+		present []string
+		evicted []string
+	}{
+		{
+			name: "below limit",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Set("b", 2, _long)
+			},
+			present: []string{"a", "b"},
+		},
+		{
+			name: "oldest is pushed out",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Set("b", 2, _long)
+				c.Set("c", 3, _long)
+				c.Set("d", 4, _long)
+			},
+			present: []string{"b", "c", "d"},
+			evicted: []string{"a"},
+		},
+		{
+			name: "get makes item recent",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Set("b", 2, _long)
+				c.Set("c", 3, _long)
+				c.Get("a")
+				c.Set("d", 4, _long)
+			},
+			present: []string{"a", "c", "d"},
+			evicted: []string{"b"},
+		},
+		{
+			name: "set replaces value without eviction",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Set("b", 2, _long)
+				c.Set("c", 3, _long)
+				c.Set("a", 10, _long)
+			},
+			present: []string{"a", "b", "c"},
+		},
+		{
+			name: "expired item isn't reported as evicted",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, -_long)
+				c.Set("b", 2, _long)
+				c.Set("c", 3, _long)
+				c.Set("d", 4, _long)
+			},
+			present: []string{"b", "c", "d"},
+		},
+		{
+			name: "deleted item frees place",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Set("b", 2, _long)
+				c.Set("c", 3, _long)
+				c.Delete("a")
+				c.Set("d", 4, _long)
+			},
+			present: []string{"b", "c", "d"},
+		},
+		{
+			name: "add keeps alive item",
+			fill: func(c *cache.Cache[string, int]) {
+				c.Set("a", 1, _long)
+				c.Add("a", 10, _long)
+			},
+			present: []string{"a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var evicted []string
+
+			c := cache.New[string, int](3, func(key string, _ int) {
+				evicted = append(evicted, key)
+			})
+			tc.fill(c)
+
+			if !slices.Equal(evicted, tc.evicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tc.evicted)
+			}
+			if c.Len() != len(tc.present) {
+				t.Errorf("Len = %d, want %d", c.Len(), len(tc.present))
+			}
+			for _, key := range tc.present {
+				if _, ok := c.Get(key); !ok {
+					t.Errorf("%s isn't in cache", key)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ttl   time.Duration
+		added bool
+	}{
+		{
+			name:  "absent key",
+			added: true,
+		},
+		{
+			name: "alive key",
+			ttl:  _long,
+		},
+		{
+			name:  "expired key",
+			ttl:   -_long,
+			added: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := cache.New[string, int](10, nil)
+			if tc.ttl != 0 {
+				c.Set("a", 1, tc.ttl)
+			}
+
+			if added := c.Add("a", 2, _long); added != tc.added {
+				t.Errorf("Add = %t, want %t", added, tc.added)
+			}
+
+			if v, _ := c.Get("a"); tc.added && v != 2 || !tc.added && v != 1 {
+				t.Errorf("Get = %d after Add = %t", v, tc.added)
+			}
+		})
+	}
+}
+
+func TestCacheAddLive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ttls  []time.Duration
+		key   string
+		added bool
+		err   error
+		len   int
+	}{
+		{
+			name:  "free place",
+			ttls:  []time.Duration{_long},
+			key:   "new",
+			added: true,
+			len:   2,
+		},
+		{
+			name: "full of alive items",
+			ttls: []time.Duration{_long, _long},
+			key:  "new",
+			err:  cache.ErrFull,
+			len:  2,
+		},
+		{
+			name:  "expired items make place",
+			ttls:  []time.Duration{_long, -_long},
+			key:   "new",
+			added: true,
+			len:   2,
+		},
+		{
+			name: "alive key",
+			ttls: []time.Duration{_long, _long},
+			key:  "0",
+			len:  2,
+		},
+		{
+			name:  "expired key is replaced in full cache",
+			ttls:  []time.Duration{-_long, _long},
+			key:   "0",
+			added: true,
+			len:   2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := cache.New[string, int](2, func(key string, _ int) {
+				t.Errorf("alive %s is pushed out", key)
+			})
+			for i, ttl := range tc.ttls {
+				c.Set(string(rune('0'+i)), i, ttl)
+			}
+
+			added, err := c.AddLive(tc.key, 10, _long)
+			if added != tc.added || !errors.Is(err, tc.err) {
+				t.Errorf("AddLive = %t, %v, want %t, %v", added, err, tc.added, tc.err)
+			}
+			if c.Len() != tc.len {
+				t.Errorf("Len = %d, want %d", c.Len(), tc.len)
+			}
+		})
+	}
+}
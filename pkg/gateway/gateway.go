@@ -0,0 +1,94 @@
+// Package gateway implements HTTP/JSON transcoding for gRPC services served in the same process.
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type method struct {
+	impl any
+	desc grpc.MethodDesc
+}
+
+// Gateway - grpc.ServiceRegistrar calling unary methods of registered services with JSON messages.
+type Gateway struct {
+	interceptors []grpc.UnaryServerInterceptor
+	methods      map[string]method
+}
+
+var _ grpc.ServiceRegistrar = (*Gateway)(nil)
+
+// New -.
+func New(opts ...Option) *Gateway {
+	g := &Gateway{
+		methods: make(map[string]method),
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// RegisterService - streaming methods are not exposed.
+func (g *Gateway) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	for _, m := range desc.Methods {
+		g.methods[fmt.Sprintf("/%s/%s", desc.ServiceName, m.MethodName)] = method{impl: impl, desc: m}
+	}
+}
+
+// Invoke - call method, e.g. "/auth.v1.UserService/GetUser", with request in protojson, incoming metadata is taken from ctx.
+// Returned errors are gRPC statuses.
+func (g *Gateway) Invoke(ctx context.Context, fullMethod string, body []byte) ([]byte, error) {
+	m, ok := g.methods[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+
+	dec := func(in any) error {
+		if len(body) == 0 {
+			return nil
+		}
+
+		if err := protojson.Unmarshal(body, in.(proto.Message)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+		}
+
+		return nil
+	}
+
+	resp, err := m.desc.Handler(m.impl, ctx, dec, g.intercept)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := protojson.Marshal(resp.(proto.Message))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "can't marshal response: %s", err)
+	}
+
+	return out, nil
+}
+
+// intercept - run interceptors in order, the same way as grpc.ChainUnaryInterceptor.
+func (g *Gateway) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return g.next(0, info, handler)(ctx, req)
+}
+
+func (g *Gateway) next(i int, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	if i == len(g.interceptors) {
+		return handler
+	}
+
+	return func(ctx context.Context, req any) (any, error) {
+		return g.interceptors[i](ctx, req, info, g.next(i+1, info, handler))
+	}
+}
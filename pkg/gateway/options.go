@@ -0,0 +1,13 @@
+package gateway
+
+import "google.golang.org/grpc"
+
+// Option -.
+type Option func(*Gateway)
+
+// Interceptors - unary interceptors of gRPC server, so calls through gateway follow the same rules.
+func Interceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(g *Gateway) {
+		g.interceptors = append(g.interceptors, interceptors...)
+	}
+}
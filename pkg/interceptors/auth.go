@@ -0,0 +1,211 @@
+// Package interceptors implements gRPC server interceptors.
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Principal - authenticated caller, either user with JWT or service with mTLS certificate.
+type Principal struct {
+	Uid     uint64
+	Role    string
+	Subject string
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext -.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+
+	return principal, ok
+}
+
+// ContextWithPrincipal -.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+type userClaim struct {
+	jwt.RegisteredClaims
+	Uid          uint64
+	Role         string
+	PermsVersion uint64
+	TokenVersion uint64
+}
+
+// TokenCheck - reports whether token of user is not revoked, permsVersion is version of role permissions in token.
+type TokenCheck func(ctx context.Context, uid, tokenVersion, permsVersion uint64) (bool, error)
+
+var jwtPattern = regexp.MustCompile(`^Bearer\s([A-Za-z0-9\-._~+\/]+=*)$`)
+
+// Auth - authenticates callers and checks per method role rules, same semantics as AuthMiddleware and RoleMiddleware.
+type Auth struct {
+	jwtSecret   string
+	clientRoles map[string]string
+	rules       map[string][]string
+	public      map[string]struct{}
+	checks      []TokenCheck
+}
+
+// NewAuth - methods without rule are denied for everyone except public ones.
+func NewAuth(jwtSecret string, opts ...AuthOption) *Auth {
+	a := &Auth{
+		jwtSecret:   jwtSecret,
+		clientRoles: make(map[string]string),
+		rules:       make(map[string][]string),
+		public:      make(map[string]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Unary -.
+func (a *Auth) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream -.
+func (a *Auth) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Auth) authorize(ctx context.Context, method string) (context.Context, error) {
+	if _, ok := a.public[method]; ok {
+		return ctx, nil
+	}
+
+	principal, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.allowed(method, principal.Role) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return ContextWithPrincipal(ctx, principal), nil
+}
+
+// authenticate - bearer token has priority, client certificate is used when there is no authorization metadata.
+func (a *Auth) authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get("authorization"); len(values) > 0 {
+		return a.tokenPrincipal(ctx, values[0])
+	}
+
+	if principal, ok := a.certPrincipal(ctx); ok {
+		return principal, nil
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "access denied, no token provided")
+}
+
+func (a *Auth) tokenPrincipal(ctx context.Context, header string) (*Principal, error) {
+	matches := jwtPattern.FindStringSubmatch(header)
+	if len(matches) != 2 {
+		return nil, status.Error(codes.Unauthenticated, "invalid token format")
+	}
+
+	var claim userClaim
+
+	token, err := jwt.ParseWithClaims(matches[1], &claim, func(token *jwt.Token) (interface{}, error) {
+		return []byte(a.jwtSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, status.Error(codes.Unauthenticated, "expired token")
+	}
+	if err != nil || !token.Valid {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	for _, check := range a.checks {
+		valid, err := check(ctx, claim.Uid, claim.TokenVersion, claim.PermsVersion)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "can't check token")
+		}
+		if !valid {
+			return nil, status.Error(codes.Unauthenticated, "revoked token")
+		}
+	}
+
+	return &Principal{Uid: claim.Uid, Role: claim.Role}, nil
+}
+
+// certPrincipal - role of mTLS client is looked up by common name of its verified certificate.
+func (a *Auth) certPrincipal(ctx context.Context) (*Principal, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	subject := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+
+	role, ok := a.clientRoles[subject]
+	if !ok {
+		return nil, false
+	}
+
+	return &Principal{Role: role, Subject: subject}, true
+}
+
+// allowed - exact method rule wins over service rule.
+func (a *Auth) allowed(method, role string) bool {
+	roles, ok := a.rules[method]
+	if !ok {
+		roles = a.rules[method[:strings.LastIndex(method, "/")+1]]
+	}
+
+	for _, allowedRole := range roles {
+		if role == allowedRole {
+			return true
+		}
+	}
+
+	return false
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
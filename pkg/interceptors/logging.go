@@ -0,0 +1,88 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/VmesteApp/auth-service/pkg/logger"
+)
+
+// RequestIDHeader - metadata key of request id, taken from caller or generated and sent back in response header.
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext -.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+
+	return requestID
+}
+
+// UnaryLogger - log every call with request id, status code and duration.
+func UnaryLogger(l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = withRequestID(ctx)
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logCall(ctx, l, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamLogger -.
+func StreamLogger(l logger.Interface) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestID(ss.Context())
+		start := time.Now()
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		logCall(ctx, l, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var requestID string
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(RequestIDHeader); len(values) > 0 && values[0] != "" {
+		requestID = values[0]
+	} else {
+		requestID = newRequestID()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+func logCall(ctx context.Context, l logger.Interface, method string, start time.Time, err error) {
+	code := status.Code(err)
+
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		l.Error("grpc - %s - %s - %s - request_id=%s - %s", method, code, time.Since(start), RequestIDFromContext(ctx), err)
+	default:
+		l.Info("grpc - %s - %s - %s - request_id=%s", method, code, time.Since(start), RequestIDFromContext(ctx))
+	}
+}
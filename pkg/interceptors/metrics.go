@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Completed RPCs by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcHandlingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "auth",
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Duration of RPCs by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+// UnaryMetrics - export RPC counters and latency histograms to default prometheus registry.
+func UnaryMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		observe(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamMetrics -.
+func StreamMetrics() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		observe(info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func observe(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+
+	grpcHandled.WithLabelValues(method, code).Inc()
+	grpcHandlingDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
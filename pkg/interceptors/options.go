@@ -0,0 +1,36 @@
+package interceptors
+
+// AuthOption -.
+type AuthOption func(*Auth)
+
+// ClientRoles - roles of mTLS clients by certificate common name.
+func ClientRoles(roles map[string]string) AuthOption {
+	return func(a *Auth) {
+		for subject, role := range roles {
+			a.clientRoles[subject] = role
+		}
+	}
+}
+
+// Allow - roles allowed to call method, e.g. "/auth.v1.UserService/GetUser", or every method of service, e.g. "/auth.v1.UserService/".
+func Allow(method string, roles ...string) AuthOption {
+	return func(a *Auth) {
+		a.rules[method] = append(a.rules[method], roles...)
+	}
+}
+
+// Public - methods callable without authentication.
+func Public(methods ...string) AuthOption {
+	return func(a *Auth) {
+		for _, method := range methods {
+			a.public[method] = struct{}{}
+		}
+	}
+}
+
+// CheckTokens - checks run for every bearer token after signature validation.
+func CheckTokens(checks ...TokenCheck) AuthOption {
+	return func(a *Auth) {
+		a.checks = append(a.checks, checks...)
+	}
+}
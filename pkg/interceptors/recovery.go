@@ -0,0 +1,44 @@
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VmesteApp/auth-service/pkg/logger"
+)
+
+// UnaryRecovery - turn handler panic into codes.Internal instead of crashing the process.
+func UnaryRecovery(l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, l, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery -.
+func StreamRecovery(l logger.Interface) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), l, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, l logger.Interface, method string, r any) error {
+	l.Error("grpc - %s - request_id=%s - panic: %v\n%s", method, RequestIDFromContext(ctx), r, debug.Stack())
+
+	return status.Error(codes.Internal, "internal error")
+}
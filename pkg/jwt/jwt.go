@@ -23,3 +23,19 @@ func NewToken(claims map[string]any, secret string, duration time.Duration) (str
 
 	return tokenString, nil
 }
+
+func ParseToken(tokenString, secret string) (map[string]any, error) {
+	claims := jwt.MapClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("can't parse token: %w", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("token is invalid")
+	}
+
+	return claims, nil
+}
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"regexp"
@@ -11,13 +12,19 @@ import (
 
 type UserClaim struct {
 	jwt.RegisteredClaims
-	Uid  uint64
-	Role string
+	Uid          uint64
+	Role         string
+	Perms        []string
+	PermsVersion uint64
+	TokenVersion uint64
 }
 
+// TokenCheck - reports whether token of user is not revoked, permsVersion is version of role permissions in token.
+type TokenCheck func(ctx context.Context, uid, tokenVersion, permsVersion uint64) (bool, error)
+
 var jwtPattern = regexp.MustCompile(`^Bearer\s([A-Za-z0-9\-._~+\/]+=*)$`)
 
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+func AuthMiddleware(jwtSecret string, checks ...TokenCheck) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		header := c.Request.Header.Get("Authorization")
 		if header == "" {
@@ -51,8 +58,23 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
+		for _, check := range checks {
+			valid, err := check(c.Request.Context(), userClaim.Uid, userClaim.TokenVersion, userClaim.PermsVersion)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"message": "Can't check token."})
+				c.Abort()
+				return
+			}
+			if !valid {
+				c.JSON(http.StatusUnauthorized, gin.H{"message": "Revoked token."})
+				c.Abort()
+				return
+			}
+		}
+
 		c.Set("uid", userClaim.Uid)
 		c.Set("role", userClaim.Role)
+		c.Set("perms", userClaim.Perms)
 
 		c.Next()
 	}
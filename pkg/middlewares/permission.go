@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HasPermission - token of request is granted perm, for checks depending on request params.
+func HasPermission(c *gin.Context, perm string) bool {
+	userPerms, _ := c.Get("perms")
+	granted, _ := userPerms.([]string)
+
+	return slices.Contains(granted, perm)
+}
+
+// RequirePermission passes only tokens granted all of perms.
+func RequirePermission(perms ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		granted, exists := c.Get("perms")
+		if !exists {
+			c.JSON(http.StatusForbidden, gin.H{"message": "Permissions not found."})
+			c.Abort()
+			return
+		}
+
+		userPerms, ok := granted.([]string)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"message": "Invalid permissions type."})
+			c.Abort()
+			return
+		}
+
+		for _, perm := range perms {
+			if !slices.Contains(userPerms, perm) {
+				c.JSON(http.StatusForbidden, gin.H{"message": "Access denied."})
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
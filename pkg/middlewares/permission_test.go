@@ -0,0 +1,140 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/pkg/middlewares"
+)
+
+func TestRequirePermission(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		granted  any
+		required []string
+		code     int
+	}{
+		{
+			name:     "no perms in context",
+			required: []string{"users:read"},
+			code:     http.StatusForbidden,
+		},
+		{
+			name:     "bad perms type",
+			granted:  "users:read",
+			required: []string{"users:read"},
+			code:     http.StatusInternalServerError,
+		},
+		{
+			name:     "granted",
+			granted:  []string{"users:read", "users:write"},
+			required: []string{"users:read"},
+			code:     http.StatusOK,
+		},
+		{
+			name:     "all granted",
+			granted:  []string{"users:read", "users:write"},
+			required: []string{"users:write", "users:read"},
+			code:     http.StatusOK,
+		},
+		{
+			name:     "one of required is missing",
+			granted:  []string{"users:read"},
+			required: []string{"users:read", "users:write"},
+			code:     http.StatusForbidden,
+		},
+		{
+			name:     "empty perms",
+			granted:  []string{},
+			required: []string{"audit:read"},
+			code:     http.StatusForbidden,
+		},
+		{
+			name:    "nothing required",
+			granted: []string{},
+			code:    http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := gin.New()
+			router.GET("/",
+				func(c *gin.Context) {
+					if tc.granted != nil {
+						c.Set("perms", tc.granted)
+					}
+				},
+				middlewares.RequirePermission(tc.required...),
+				func(c *gin.Context) {
+					c.Status(http.StatusOK)
+				},
+			)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+			if w.Code != tc.code {
+				t.Errorf("code = %d, want %d", w.Code, tc.code)
+			}
+		})
+	}
+}
+
+func TestHasPermission(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		granted any
+		perm    string
+		has     bool
+	}{
+		{
+			name: "no perms in context",
+			perm: "users:read",
+		},
+		{
+			name:    "bad perms type",
+			granted: "users:read",
+			perm:    "users:read",
+		},
+		{
+			name:    "granted",
+			granted: []string{"profiles:read", "users:read"},
+			perm:    "users:read",
+			has:     true,
+		},
+		{
+			name:    "not granted",
+			granted: []string{"profiles:read"},
+			perm:    "users:read",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			if tc.granted != nil {
+				c.Set("perms", tc.granted)
+			}
+
+			if got := middlewares.HasPermission(c, tc.perm); got != tc.has {
+				t.Errorf("HasPermission = %t, want %t", got, tc.has)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/VmesteApp/auth-service/pkg/reqmeta"
+)
+
+// RequestMeta puts client IP and user agent into request context.
+func RequestMeta() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := reqmeta.NewContext(c.Request.Context(), reqmeta.Meta{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		})
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,20 @@
+package probe
+
+import "time"
+
+// Option -.
+type Option func(*Probe)
+
+// Check - add named readiness check.
+func Check(name string, fn CheckFunc) Option {
+	return func(p *Probe) {
+		p.checks = append(p.checks, check{name: name, fn: fn})
+	}
+}
+
+// Timeout - per check timeout.
+func Timeout(timeout time.Duration) Option {
+	return func(p *Probe) {
+		p.timeout = timeout
+	}
+}
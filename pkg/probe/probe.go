@@ -0,0 +1,120 @@
+// Package probe implements k8s liveness and readiness state with dependency checks.
+package probe
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const _defaultTimeout = 2 * time.Second
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// CheckFunc - dependency check, nil error means dependency is healthy.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Result -.
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report -.
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Probe -.
+type Probe struct {
+	ready   atomic.Bool
+	timeout time.Duration
+	checks  []check
+}
+
+// New - probe is not ready until SetReady(true).
+func New(opts ...Option) *Probe {
+	p := &Probe{
+		timeout: _defaultTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// SetReady -.
+func (p *Probe) SetReady(ready bool) {
+	p.ready.Store(ready)
+}
+
+// Ready -.
+func (p *Probe) Ready() bool {
+	return p.ready.Load()
+}
+
+// Check - run all checks concurrently, each bounded by timeout, report is up only if probe is ready and all checks pass.
+func (p *Probe) Check(ctx context.Context) Report {
+	results := make([]Result, len(p.checks))
+
+	var wg sync.WaitGroup
+
+	for i, c := range p.checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i] = p.run(ctx, c)
+		}()
+	}
+
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: results}
+	if !p.Ready() {
+		report.Status = StatusDown
+	}
+
+	for _, result := range results {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	return report
+}
+
+func (p *Probe) run(ctx context.Context, c check) Result {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.fn(ctx)
+
+	result := Result{
+		Name:      c.name,
+		Status:    StatusUp,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,24 @@
+// Package reqmeta carries client address and user agent of the request through context.
+package reqmeta
+
+import "context"
+
+// Meta -.
+type Meta struct {
+	IP        string
+	UserAgent string
+}
+
+type metaKey struct{}
+
+// NewContext -.
+func NewContext(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// FromContext - empty Meta when context has no request meta, e.g. in operator tools.
+func FromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta) //nolint:errcheck // zero Meta is fine
+
+	return meta
+}